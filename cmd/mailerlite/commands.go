@@ -0,0 +1,495 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sagikazarmark/go-mailerlite/mailerlite"
+)
+
+type action = func(ctx context.Context, a *app, args []string) (*result, error)
+
+var commands = map[string]command{}
+
+func register(cmd command) {
+	commands[cmd.name] = cmd
+}
+
+func init() {
+	register(command{
+		name:  "subscribers get",
+		usage: "<email>",
+		args:  1,
+		setup: func(_ *flag.FlagSet) action {
+			return func(ctx context.Context, a *app, args []string) (*result, error) {
+				subscriber, _, err := a.client.Subscribers.Get(ctx, args[0])
+				if err != nil {
+					return nil, err
+				}
+
+				return subscriberResult(subscriber), nil
+			}
+		},
+	})
+
+	register(command{
+		name:  "subscribers list",
+		usage: "[--type TYPE] [--limit N] [--offset N]",
+		setup: func(flags *flag.FlagSet) action {
+			typ := flags.String("type", "", "subscription type: active, unsubscribed, unconfirmed, bounced or junk")
+			listOpts := listFlags(flags)
+
+			return func(ctx context.Context, a *app, _ []string) (*result, error) {
+				subscribers, _, err := a.client.Subscribers.List(ctx, &mailerlite.SubscriberListOptions{
+					Type:        mailerlite.SubscriptionType(*typ),
+					ListOptions: *listOpts,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				return subscribersResult(subscribers, subscribers), nil
+			}
+		},
+	})
+
+	register(command{
+		name:  "subscribers search",
+		usage: "[--limit N] [--offset N] <query>",
+		args:  1,
+		setup: func(flags *flag.FlagSet) action {
+			listOpts := listFlags(flags)
+
+			return func(ctx context.Context, a *app, args []string) (*result, error) {
+				subscribers, _, err := a.client.Subscribers.Search(ctx, args[0], &mailerlite.SubscriberSearchOptions{
+					ListOptions: *listOpts,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				return subscribersResult(subscribers, subscribers), nil
+			}
+		},
+	})
+
+	register(command{
+		name:  "subscribers update",
+		usage: "[--name NAME] [--field KEY=VALUE]... <email>",
+		args:  1,
+		setup: func(flags *flag.FlagSet) action {
+			name := flags.String("name", "", "new name of the subscriber")
+
+			var fields fieldFlags
+			flags.Var(&fields, "field", "custom field value as KEY=VALUE (repeatable)")
+
+			return func(ctx context.Context, a *app, args []string) (*result, error) {
+				update := mailerlite.SubscriberUpdate{
+					Name: *name,
+				}
+
+				if len(fields) > 0 {
+					values, err := parseFieldValues(ctx, a.client, fields)
+					if err != nil {
+						return nil, err
+					}
+
+					for _, field := range fields {
+						update.Fields = append(update.Fields, mailerlite.SubscriberField{
+							Key:   field.key,
+							Value: values[field.key],
+							Type:  values[field.key].Type(),
+						})
+					}
+				}
+
+				subscriber, _, err := a.client.Subscribers.Update(ctx, args[0], update)
+				if err != nil {
+					return nil, err
+				}
+
+				return subscriberResult(subscriber), nil
+			}
+		},
+	})
+
+	register(command{
+		name:  "subscribers unsubscribe",
+		usage: "<email>",
+		args:  1,
+		setup: func(_ *flag.FlagSet) action {
+			return func(ctx context.Context, a *app, args []string) (*result, error) {
+				subscriber, _, err := a.client.Subscribers.Update(ctx, args[0], mailerlite.SubscriberUpdate{
+					Type: mailerlite.Unsubscribed,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				return subscriberResult(subscriber), nil
+			}
+		},
+	})
+
+	register(command{
+		name:  "groups list",
+		usage: "[--limit N] [--offset N]",
+		setup: func(flags *flag.FlagSet) action {
+			listOpts := listFlags(flags)
+
+			return func(ctx context.Context, a *app, _ []string) (*result, error) {
+				groups, _, err := a.client.Groups.List(ctx, &mailerlite.GroupListOptions{ListOptions: *listOpts})
+				if err != nil {
+					return nil, err
+				}
+
+				return groupsResult(groups), nil
+			}
+		},
+	})
+
+	register(command{
+		name:  "groups members",
+		usage: "[--type TYPE] [--limit N] [--offset N] <group-id>",
+		args:  1,
+		setup: func(flags *flag.FlagSet) action {
+			typ := flags.String("type", "", "subscription type: active, unsubscribed, unconfirmed, bounced or junk")
+			listOpts := listFlags(flags)
+
+			return func(ctx context.Context, a *app, args []string) (*result, error) {
+				id, err := parseID(args[0])
+				if err != nil {
+					return nil, err
+				}
+
+				subscribers, _, err := a.client.Groups.Subscribers(ctx, id, &mailerlite.GroupSubscriberListOptions{
+					Type:        mailerlite.SubscriptionType(*typ),
+					ListOptions: *listOpts,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				return subscribersResult(subscribers, subscribers), nil
+			}
+		},
+	})
+
+	register(command{
+		name:  "groups add",
+		usage: "[--name NAME] <group-id> <email>",
+		args:  2,
+		setup: func(flags *flag.FlagSet) action {
+			name := flags.String("name", "", "name of the subscriber")
+
+			return func(ctx context.Context, a *app, args []string) (*result, error) {
+				id, err := parseID(args[0])
+				if err != nil {
+					return nil, err
+				}
+
+				subscriber, _, err := a.client.Groups.AddSubscriber(ctx, id, mailerlite.NewSubscriberInGroup{
+					Email: args[1],
+					Name:  *name,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				return subscriberResult(subscriber), nil
+			}
+		},
+	})
+
+	register(command{
+		name:  "groups remove",
+		usage: "<group-id> <email>",
+		args:  2,
+		setup: func(_ *flag.FlagSet) action {
+			return func(ctx context.Context, a *app, args []string) (*result, error) {
+				id, err := parseID(args[0])
+				if err != nil {
+					return nil, err
+				}
+
+				_, err = a.client.Groups.RemoveSubscriber(ctx, id, args[1])
+				if err != nil {
+					return nil, err
+				}
+
+				fmt.Fprintf(a.stderr, "removed %s from group %d\n", args[1], id)
+
+				return nil, nil
+			}
+		},
+	})
+
+	register(command{
+		name:  "fields list",
+		usage: "",
+		setup: func(_ *flag.FlagSet) action {
+			return func(ctx context.Context, a *app, _ []string) (*result, error) {
+				fields, _, err := a.client.Fields.List(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				return fieldsResult(fields, fields), nil
+			}
+		},
+	})
+
+	register(command{
+		name:  "fields create",
+		usage: "[--type TYPE] <title>",
+		args:  1,
+		setup: func(flags *flag.FlagSet) action {
+			typ := flags.String("type", string(mailerlite.Text), "field type: TEXT, NUMBER or DATE")
+
+			return func(ctx context.Context, a *app, args []string) (*result, error) {
+				field, _, err := a.client.Fields.Create(ctx, mailerlite.NewField{
+					Title: args[0],
+					Type:  mailerlite.FieldType(strings.ToUpper(*typ)),
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				return fieldsResult(field, []mailerlite.Field{*field}), nil
+			}
+		},
+	})
+
+	register(command{
+		name:  "fields update",
+		usage: "<field-id> <title>",
+		args:  2,
+		setup: func(_ *flag.FlagSet) action {
+			return func(ctx context.Context, a *app, args []string) (*result, error) {
+				id, err := parseID(args[0])
+				if err != nil {
+					return nil, err
+				}
+
+				field, _, err := a.client.Fields.Update(ctx, id, mailerlite.FieldUpdate{Title: args[1]})
+				if err != nil {
+					return nil, err
+				}
+
+				return fieldsResult(field, []mailerlite.Field{*field}), nil
+			}
+		},
+	})
+
+	register(command{
+		name:  "fields delete",
+		usage: "<field-id>",
+		args:  1,
+		setup: func(_ *flag.FlagSet) action {
+			return func(ctx context.Context, a *app, args []string) (*result, error) {
+				id, err := parseID(args[0])
+				if err != nil {
+					return nil, err
+				}
+
+				_, err = a.client.Fields.Delete(ctx, id)
+				if err != nil {
+					return nil, err
+				}
+
+				fmt.Fprintf(a.stderr, "deleted field %d\n", id)
+
+				return nil, nil
+			}
+		},
+	})
+
+	register(command{
+		name:  "stats",
+		usage: "[--at YYYY-MM-DD]",
+		setup: func(flags *flag.FlagSet) action {
+			at := flags.String("at", "", "show stats at a point in the past")
+
+			return func(ctx context.Context, a *app, _ []string) (*result, error) {
+				var opts mailerlite.StatGetOptions
+
+				if *at != "" {
+					t, err := time.Parse("2006-01-02", *at)
+					if err != nil {
+						return nil, fmt.Errorf("invalid date %q: %w", *at, err)
+					}
+
+					opts.Timestamp = t.Unix()
+				}
+
+				stats, _, err := a.client.Stats.Get(ctx, &opts)
+				if err != nil {
+					return nil, err
+				}
+
+				return &result{
+					value:   stats,
+					columns: []string{"stat", "value"},
+					rows: [][]string{
+						{"subscribed", strconv.Itoa(int(stats.Subscribed))},
+						{"unsubscribed", strconv.Itoa(int(stats.Unsubscribed))},
+						{"campaigns", strconv.Itoa(int(stats.Campaigns))},
+						{"sent_emails", strconv.Itoa(int(stats.SentEmails))},
+						{"open_rate", formatFloat(float64(stats.OpenRate))},
+						{"click_rate", formatFloat(float64(stats.ClickRate))},
+						{"bounce_rate", formatFloat(float64(stats.BounceRate))},
+					},
+				}, nil
+			}
+		},
+	})
+
+	register(command{
+		name:  "webhooks list",
+		usage: "",
+		setup: func(_ *flag.FlagSet) action {
+			return func(ctx context.Context, a *app, _ []string) (*result, error) {
+				webhooks, _, err := a.client.Webhooks.List(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				res := &result{
+					value:   webhooks,
+					columns: []string{"id", "event", "url", "date_created"},
+				}
+
+				for _, webhook := range webhooks {
+					webhook := webhook
+
+					res.rows = append(res.rows, []string{
+						strconv.Itoa(webhook.ID),
+						webhook.Event,
+						webhook.URL,
+						formatTimestamp(&webhook.DateCreated),
+					})
+				}
+
+				return res, nil
+			}
+		},
+	})
+
+	register(command{
+		name:  "campaigns list",
+		usage: "[--status STATUS] [--limit N] [--offset N]",
+		setup: func(flags *flag.FlagSet) action {
+			status := flags.String("status", string(mailerlite.CampaignSent), "campaign status: sent, draft or outbox")
+			listOpts := listFlags(flags)
+
+			return func(ctx context.Context, a *app, _ []string) (*result, error) {
+				campaigns, _, err := a.client.Campaigns.List(ctx, mailerlite.CampaignStatus(*status), &mailerlite.CampaignListOptions{
+					ListOptions: *listOpts,
+				})
+				if err != nil {
+					return nil, err
+				}
+
+				res := &result{
+					value:   campaigns,
+					columns: []string{"id", "name", "status", "recipients", "opened", "clicked", "date_send"},
+				}
+
+				for _, campaign := range campaigns {
+					res.rows = append(res.rows, []string{
+						strconv.Itoa(campaign.ID),
+						campaign.Name,
+						string(campaign.Status),
+						strconv.Itoa(campaign.TotalRecipients),
+						strconv.Itoa(campaign.Opened.Count),
+						strconv.Itoa(campaign.Clicked.Count),
+						formatTimestamp(campaign.DateSend),
+					})
+				}
+
+				return res, nil
+			}
+		},
+	})
+}
+
+func listFlags(flags *flag.FlagSet) *mailerlite.ListOptions {
+	var opts mailerlite.ListOptions
+
+	flags.IntVar(&opts.Limit, "limit", 0, "maximum number of items to return")
+	flags.IntVar(&opts.Offset, "offset", 0, "number of items to skip")
+
+	return &opts
+}
+
+func parseID(s string) (int, error) {
+	id, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid ID %q", s)
+	}
+
+	return id, nil
+}
+
+type fieldFlag struct {
+	key   string
+	value string
+}
+
+// fieldFlags collects repeated KEY=VALUE flags.
+type fieldFlags []fieldFlag
+
+func (f *fieldFlags) String() string {
+	parts := make([]string, 0, len(*f))
+	for _, field := range *f {
+		parts = append(parts, field.key+"="+field.value)
+	}
+
+	return strings.Join(parts, ",")
+}
+
+func (f *fieldFlags) Set(s string) error {
+	key, value, ok := strings.Cut(s, "=")
+	if !ok || key == "" {
+		return fmt.Errorf("expected KEY=VALUE, got %q", s)
+	}
+
+	*f = append(*f, fieldFlag{key: key, value: value})
+
+	return nil
+}
+
+// parseFieldValues parses field values according to the field types of the account.
+func parseFieldValues(ctx context.Context, client *mailerlite.Client, fields fieldFlags) (map[string]mailerlite.FieldValue, error) {
+	schema, _, err := client.Fields.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	types := make(map[string]mailerlite.FieldType, len(schema))
+	for _, field := range schema {
+		types[string(field.Key)] = field.Type
+	}
+
+	values := make(map[string]mailerlite.FieldValue, len(fields))
+
+	for _, field := range fields {
+		typ, ok := types[field.key]
+		if !ok {
+			return nil, fmt.Errorf("unknown field %q", field.key)
+		}
+
+		value, err := mailerlite.ParseFieldValue(typ, field.value)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", field.key, err)
+		}
+
+		values[field.key] = value
+	}
+
+	return values, nil
+}
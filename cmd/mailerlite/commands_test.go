@@ -0,0 +1,312 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const testSubscriber = `{"id":1,"email":"john@example.com","name":"John","type":"active","sent":3,"opened":2,"clicked":1,"date_created":"2022-01-01 10:00:00"}`
+
+const testFields = `[
+	{"id":1,"key":"company","title":"Company","type":"TEXT"},
+	{"id":2,"key":"seats","title":"Seats","type":"NUMBER"}
+]`
+
+func testMethod(t *testing.T, r *http.Request, want string) {
+	t.Helper()
+
+	if got := r.Method; got != want {
+		t.Errorf("request method: got %s, want %s", got, want)
+	}
+}
+
+func decodeBody(t *testing.T, r *http.Request, v interface{}) {
+	t.Helper()
+
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		t.Errorf("decoding request body: %v", err)
+	}
+}
+
+func TestSubscribersUpdate(t *testing.T) {
+	mux, run := setup(t)
+
+	mux.HandleFunc("/fields", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		_, _ = w.Write([]byte(testFields))
+	})
+
+	mux.HandleFunc("/subscribers/john@example.com", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+
+		var update struct {
+			Name   string `json:"name"`
+			Fields []struct {
+				Key   string      `json:"key"`
+				Value interface{} `json:"value"`
+				Type  string      `json:"type"`
+			} `json:"fields"`
+		}
+		decodeBody(t, r, &update)
+
+		if update.Name != "John" {
+			t.Errorf("name: got %q, want %q", update.Name, "John")
+		}
+
+		if len(update.Fields) != 2 ||
+			update.Fields[0].Key != "company" || update.Fields[0].Value != "Acme" || update.Fields[0].Type != "TEXT" ||
+			update.Fields[1].Key != "seats" || update.Fields[1].Value != 5.0 || update.Fields[1].Type != "NUMBER" {
+			t.Errorf("unexpected fields: %+v", update.Fields)
+		}
+
+		_, _ = w.Write([]byte(`{"id":1,"email":"john@example.com","name":"John","type":"active","fields":[{"key":"company","value":"Acme","type":"TEXT"}]}`))
+	})
+
+	stdout, _, err := run("subscribers", "update", "--name", "John", "--field", "company=Acme", "--field", "seats=5", "--output", "csv", "john@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{"field,value\n", "email,john@example.com\n", "name,John\n", "fields.company,Acme\n"} {
+		if !strings.Contains(stdout, want) {
+			t.Errorf("output should contain %q:\n%s", want, stdout)
+		}
+	}
+}
+
+func TestSubscribersUpdate_InvalidField(t *testing.T) {
+	mux, run := setup(t)
+
+	mux.HandleFunc("/fields", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testFields))
+	})
+
+	mux.HandleFunc("/subscribers/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+	})
+
+	tests := map[string]struct {
+		args []string
+		want string
+	}{
+		"unknown field":   {args: []string{"--field", "plan=pro"}, want: `unknown field "plan"`},
+		"invalid number":  {args: []string{"--field", "seats=many"}, want: `field "seats"`},
+		"invalid flag":    {args: []string{"--field", "company"}, want: "expected KEY=VALUE"},
+		"missing email":   {want: "expected 1 argument(s), got 0"},
+		"too many emails": {args: []string{"john@example.com"}, want: "expected 1 argument(s), got 2"},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			args := append([]string{"subscribers", "update"}, test.args...)
+			if name != "missing email" {
+				args = append(args, "john@example.com")
+			}
+
+			_, _, err := run(args...)
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("expected an error containing %q, got %v", test.want, err)
+			}
+		})
+	}
+}
+
+func TestSubscribersUnsubscribe(t *testing.T) {
+	mux, run := setup(t)
+
+	mux.HandleFunc("/subscribers/john@example.com", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+
+		var update map[string]interface{}
+		decodeBody(t, r, &update)
+
+		if len(update) != 1 || update["type"] != "unsubscribed" {
+			t.Errorf("unexpected update: %v", update)
+		}
+
+		_, _ = w.Write([]byte(`{"id":1,"email":"john@example.com","type":"unsubscribed"}`))
+	})
+
+	stdout, _, err := run("subscribers", "unsubscribe", "--output", "json", "john@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var subscriber map[string]interface{}
+	if err := json.Unmarshal([]byte(stdout), &subscriber); err != nil {
+		t.Fatalf("expected JSON output: %v\n%s", err, stdout)
+	}
+
+	if subscriber["type"] != "unsubscribed" {
+		t.Errorf("unexpected output: %s", stdout)
+	}
+
+	if _, _, err := run("subscribers", "unsubscribe"); err == nil || !strings.Contains(err.Error(), "expected 1 argument(s), got 0") {
+		t.Errorf("expected an argument error, got %v", err)
+	}
+}
+
+func TestGroupsAdd(t *testing.T) {
+	mux, run := setup(t)
+
+	mux.HandleFunc("/groups/1/subscribers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var subscriber map[string]interface{}
+		decodeBody(t, r, &subscriber)
+
+		if subscriber["email"] != "john@example.com" || subscriber["name"] != "John" {
+			t.Errorf("unexpected subscriber: %v", subscriber)
+		}
+
+		_, _ = w.Write([]byte(testSubscriber))
+	})
+
+	stdout, _, err := run("groups", "add", "--name", "John", "--output", "csv", "1", "john@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{"id,1\n", "email,john@example.com\n", "date_created,2022-01-01 10:00:00\n"} {
+		if !strings.Contains(stdout, want) {
+			t.Errorf("output should contain %q:\n%s", want, stdout)
+		}
+	}
+}
+
+func TestGroupsRemove(t *testing.T) {
+	mux, run := setup(t)
+
+	mux.HandleFunc("/groups/1/subscribers/john@example.com", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	stdout, stderr, err := run("groups", "remove", "1", "john@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if stdout != "" {
+		t.Errorf("unexpected output: %s", stdout)
+	}
+
+	if want := "removed john@example.com from group 1\n"; stderr != want {
+		t.Errorf("stderr: got %q, want %q", stderr, want)
+	}
+}
+
+func TestFieldsCreate(t *testing.T) {
+	mux, run := setup(t)
+
+	mux.HandleFunc("/fields", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var field map[string]interface{}
+		decodeBody(t, r, &field)
+
+		if field["title"] != "Seats" || field["type"] != "NUMBER" {
+			t.Errorf("unexpected field: %v", field)
+		}
+
+		_, _ = w.Write([]byte(`{"id":2,"key":"seats","title":"Seats","type":"NUMBER","date_created":"2022-01-01 10:00:00"}`))
+	})
+
+	stdout, _, err := run("fields", "create", "--type", "number", "--output", "csv", "Seats")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "id,key,title,type,date_created\n2,seats,Seats,NUMBER,2022-01-01 10:00:00\n"; stdout != want {
+		t.Errorf("output:\ngot\n%s\nwant\n%s", stdout, want)
+	}
+}
+
+func TestFieldsUpdate(t *testing.T) {
+	mux, run := setup(t)
+
+	mux.HandleFunc("/fields/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+
+		var update map[string]interface{}
+		decodeBody(t, r, &update)
+
+		if len(update) != 1 || update["title"] != "Licenses" {
+			t.Errorf("unexpected update: %v", update)
+		}
+
+		_, _ = w.Write([]byte(`{"id":2,"key":"seats","title":"Licenses","type":"NUMBER"}`))
+	})
+
+	stdout, _, err := run("fields", "update", "--output", "csv", "2", "Licenses")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "id,key,title,type,date_created\n2,seats,Licenses,NUMBER,\n"; stdout != want {
+		t.Errorf("output:\ngot\n%s\nwant\n%s", stdout, want)
+	}
+}
+
+func TestFieldsDelete(t *testing.T) {
+	mux, run := setup(t)
+
+	mux.HandleFunc("/fields/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	stdout, stderr, err := run("fields", "delete", "2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if stdout != "" {
+		t.Errorf("unexpected output: %s", stdout)
+	}
+
+	if want := "deleted field 2\n"; stderr != want {
+		t.Errorf("stderr: got %q, want %q", stderr, want)
+	}
+}
+
+func TestWriteCommands_InvalidArguments(t *testing.T) {
+	mux, run := setup(t)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+	})
+
+	tests := map[string]struct {
+		args []string
+		want string
+	}{
+		"groups add invalid id":      {args: []string{"groups", "add", "one", "john@example.com"}, want: `invalid ID "one"`},
+		"groups add missing email":   {args: []string{"groups", "add", "1"}, want: "expected 2 argument(s), got 1"},
+		"groups remove invalid id":   {args: []string{"groups", "remove", "one", "john@example.com"}, want: `invalid ID "one"`},
+		"groups remove missing args": {args: []string{"groups", "remove"}, want: "expected 2 argument(s), got 0"},
+		"fields create missing":      {args: []string{"fields", "create"}, want: "expected 1 argument(s), got 0"},
+		"fields update invalid id":   {args: []string{"fields", "update", "two", "Licenses"}, want: `invalid ID "two"`},
+		"fields update missing":      {args: []string{"fields", "update", "2"}, want: "expected 2 argument(s), got 1"},
+		"fields delete invalid id":   {args: []string{"fields", "delete", "two"}, want: `invalid ID "two"`},
+		"fields delete too many":     {args: []string{"fields", "delete", "2", "3"}, want: "expected 1 argument(s), got 2"},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			_, _, err := run(test.args...)
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("expected an error containing %q, got %v", test.want, err)
+			}
+		})
+	}
+}
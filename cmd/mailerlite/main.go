@@ -0,0 +1,288 @@
+// Command mailerlite is a command line client for everyday MailerLite account operations.
+//
+// Usage:
+//
+//	mailerlite [global flags] <resource> [<action>] [flags] [arguments]
+//
+// Global flags (eg. --output) can be passed after the command name as well.
+//
+// The API key is read from the --api-key flag, the MAILERLITE_API_KEY environment variable
+// or the api_key entry of the configuration file (in this order).
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/sagikazarmark/go-mailerlite/mailerlite"
+)
+
+const (
+	envAPIKey  = "MAILERLITE_API_KEY"
+	envBaseURL = "MAILERLITE_BASE_URL"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	err := run(ctx, os.Args[1:], os.Stdout, os.Stderr)
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "mailerlite:", err)
+		os.Exit(1)
+	}
+}
+
+// config is the content of the configuration file.
+type config struct {
+	APIKey  string `json:"api_key"`
+	BaseURL string `json:"base_url"`
+}
+
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(dir, "mailerlite", "config.json")
+}
+
+func loadConfig(path string, explicit bool) (config, error) {
+	var cfg config
+
+	if path == "" {
+		return cfg, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) && !explicit {
+		return cfg, nil
+	}
+	if err != nil {
+		return cfg, err
+	}
+
+	err = json.Unmarshal(data, &cfg)
+	if err != nil {
+		return cfg, fmt.Errorf("parsing config file %s: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+// app holds the state shared by commands.
+type app struct {
+	client *mailerlite.Client
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// globalFlags are the flags accepted both before and after the command name.
+type globalFlags struct {
+	apiKey     *string
+	baseURL    *string
+	output     *string
+	configPath *string
+}
+
+func registerGlobalFlags(flags *flag.FlagSet) globalFlags {
+	return globalFlags{
+		apiKey:     flags.String("api-key", "", "API key (defaults to $"+envAPIKey+")"),
+		baseURL:    flags.String("base-url", "", "base URL of the API (defaults to $"+envBaseURL+")"),
+		output:     flags.String("output", "table", "output format: table, json or csv"),
+		configPath: flags.String("config", "", "path of the configuration file (defaults to "+defaultConfigPath()+")"),
+	}
+}
+
+func run(ctx context.Context, args []string, stdout io.Writer, stderr io.Writer) error {
+	flags := flag.NewFlagSet("mailerlite", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	global := registerGlobalFlags(flags)
+
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: mailerlite [global flags] <resource> [<action>] [flags] [arguments]")
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "Commands:")
+		printCommands(stderr)
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "Global flags:")
+		flags.PrintDefaults()
+	}
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	cmd, cmdArgs, err := findCommand(flags.Args())
+	if err != nil {
+		flags.Usage()
+
+		return err
+	}
+
+	cmdFlags := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+	cmdFlags.SetOutput(stderr)
+
+	runCmd := cmd.setup(cmdFlags)
+
+	// Global flags are accepted after the command name as well
+	registerGlobalFlags(cmdFlags)
+
+	cmdFlags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: mailerlite %s %s\n", cmd.name, cmd.usage)
+		cmdFlags.PrintDefaults()
+	}
+
+	err = cmdFlags.Parse(cmdArgs)
+	if err != nil {
+		return err
+	}
+
+	// Global flags set after the command name take precedence
+	cmdFlags.Visit(func(f *flag.Flag) {
+		if global := flags.Lookup(f.Name); global != nil {
+			_ = global.Value.Set(f.Value.String())
+		}
+	})
+
+	if cmdFlags.NArg() != cmd.args {
+		cmdFlags.Usage()
+
+		return fmt.Errorf("%s: expected %d argument(s), got %d", cmd.name, cmd.args, cmdFlags.NArg())
+	}
+
+	switch *global.output {
+	case "table", "json", "csv":
+	default:
+		return fmt.Errorf("invalid output format %q", *global.output)
+	}
+
+	client, err := newClient(global)
+	if err != nil {
+		return err
+	}
+
+	a := &app{
+		client: client,
+		stdout: stdout,
+		stderr: stderr,
+	}
+
+	res, err := runCmd(ctx, a, cmdFlags.Args())
+	if err != nil {
+		return err
+	}
+
+	if res == nil {
+		return nil
+	}
+
+	return render(stdout, *global.output, res)
+}
+
+// newClient creates a client from the global flags, the environment and the configuration file.
+func newClient(global globalFlags) (*mailerlite.Client, error) {
+	cfg, err := loadConfig(firstNonEmpty(*global.configPath, defaultConfigPath()), *global.configPath != "")
+	if err != nil {
+		return nil, err
+	}
+
+	key := firstNonEmpty(*global.apiKey, os.Getenv(envAPIKey), cfg.APIKey)
+	if key == "" {
+		return nil, fmt.Errorf("missing API key: use --api-key, $%s or the configuration file", envAPIKey)
+	}
+
+	var opts []mailerlite.ClientOption
+
+	if u := firstNonEmpty(*global.baseURL, os.Getenv(envBaseURL), cfg.BaseURL); u != "" {
+		if !strings.HasSuffix(u, "/") {
+			u += "/"
+		}
+
+		parsed, err := url.Parse(u)
+		if err != nil {
+			return nil, fmt.Errorf("invalid base URL: %w", err)
+		}
+
+		opts = append(opts, mailerlite.BaseURL(parsed))
+	}
+
+	return mailerlite.NewClient(key, opts...)
+}
+
+// command is a single CLI command (eg. "subscribers get").
+type command struct {
+	name  string
+	usage string
+
+	// Number of positional arguments.
+	args int
+
+	// setup registers the flags of the command and returns its action.
+	setup func(flags *flag.FlagSet) func(ctx context.Context, a *app, args []string) (*result, error)
+}
+
+func findCommand(args []string) (command, []string, error) {
+	if len(args) == 0 {
+		return command{}, nil, errors.New("missing command")
+	}
+
+	if len(args) > 1 {
+		if cmd, ok := commands[args[0]+" "+args[1]]; ok {
+			return cmd, args[2:], nil
+		}
+	}
+
+	if cmd, ok := commands[args[0]]; ok {
+		return cmd, args[1:], nil
+	}
+
+	return command{}, nil, fmt.Errorf("unknown command %q", strings.Join(args[:minInt(2, len(args))], " "))
+}
+
+func printCommands(w io.Writer) {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintln(w, strings.TrimRight("  "+name+" "+commands[name].usage, " "))
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
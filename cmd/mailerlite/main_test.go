@@ -0,0 +1,83 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// setup starts a test HTTP server and returns its mux, and a function running the CLI against it.
+// The function returns the standard output and standard error of the command.
+func setup(t *testing.T) (*http.ServeMux, func(args ...string) (string, string, error)) {
+	t.Helper()
+
+	t.Setenv(envAPIKey, "")
+	t.Setenv(envBaseURL, "")
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	mux := http.NewServeMux()
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return mux, func(args ...string) (string, string, error) {
+		var stdout, stderr bytes.Buffer
+
+		args = append([]string{"--api-key", "api-key", "--base-url", server.URL}, args...)
+
+		err := run(context.Background(), args, &stdout, &stderr)
+
+		return stdout.String(), stderr.String(), err
+	}
+}
+
+func TestRun_GlobalFlagsAfterCommand(t *testing.T) {
+	t.Setenv(envAPIKey, "")
+	t.Setenv(envBaseURL, "")
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Path, "/stats"; got != want {
+			t.Errorf("path: got %s, want %s", got, want)
+		}
+
+		if got, want := r.Header.Get("X-MailerLite-ApiKey"), "api-key"; got != want {
+			t.Errorf("API key: got %q, want %q", got, want)
+		}
+
+		// 2040-01-01 does not fit in an int32 UNIX timestamp
+		if got, want := r.URL.Query().Get("timestamp"), "2208988800"; got != want {
+			t.Errorf("timestamp: got %s, want %s", got, want)
+		}
+
+		_, _ = w.Write([]byte(`{"subscribed":10,"unsubscribed":2,"campaigns":3,"sent_emails":30,"open_rate":0.5,"click_rate":0.1,"bounce_rate":0.01}`))
+	}))
+	defer server.Close()
+
+	var stdout, stderr bytes.Buffer
+
+	err := run(context.Background(), []string{
+		"stats",
+		"--at", "2040-01-01",
+		"--api-key", "api-key",
+		"--base-url", server.URL,
+		"--output", "json",
+	}, &stdout, &stderr)
+	if err != nil {
+		t.Fatalf("%v\n%s", err, stderr.String())
+	}
+
+	var stats map[string]interface{}
+	if err := json.Unmarshal(stdout.Bytes(), &stats); err != nil {
+		t.Fatalf("expected JSON output: %v\n%s", err, stdout.String())
+	}
+
+	if stats["subscribed"] != 10.0 {
+		t.Errorf("unexpected output: %s", stdout.String())
+	}
+}
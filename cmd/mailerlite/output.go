@@ -0,0 +1,165 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sagikazarmark/go-mailerlite/mailerlite"
+)
+
+// result is the output of a command.
+type result struct {
+	// value is rendered as is in JSON output.
+	value interface{}
+
+	// columns and rows are rendered in table and CSV output.
+	columns []string
+	rows    [][]string
+}
+
+func render(w io.Writer, format string, res *result) error {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		enc.SetEscapeHTML(false)
+
+		return enc.Encode(res.value)
+
+	case "csv":
+		cw := csv.NewWriter(w)
+
+		err := cw.Write(res.columns)
+		if err != nil {
+			return err
+		}
+
+		err = cw.WriteAll(res.rows)
+		if err != nil {
+			return err
+		}
+
+		cw.Flush()
+
+		return cw.Error()
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, strings.ToUpper(strings.Join(res.columns, "\t")))
+
+	for _, row := range res.rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+
+	return tw.Flush()
+}
+
+func formatTimestamp(t *mailerlite.Timestamp) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+
+	return t.Format("2006-01-02 15:04:05")
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func subscribersResult(value interface{}, subscribers []mailerlite.Subscriber) *result {
+	res := &result{
+		value:   value,
+		columns: []string{"id", "email", "name", "type", "sent", "opened", "clicked", "date_subscribe"},
+	}
+
+	for _, subscriber := range subscribers {
+		res.rows = append(res.rows, []string{
+			strconv.Itoa(subscriber.ID),
+			subscriber.Email,
+			subscriber.Name,
+			string(subscriber.Type),
+			strconv.Itoa(subscriber.Sent),
+			strconv.Itoa(subscriber.Opened),
+			strconv.Itoa(subscriber.Clicked),
+			formatTimestamp(subscriber.DateSubscribe),
+		})
+	}
+
+	return res
+}
+
+func subscriberResult(subscriber *mailerlite.Subscriber) *result {
+	res := &result{
+		value:   subscriber,
+		columns: []string{"field", "value"},
+		rows: [][]string{
+			{"id", strconv.Itoa(subscriber.ID)},
+			{"email", subscriber.Email},
+			{"name", subscriber.Name},
+			{"type", string(subscriber.Type)},
+			{"sent", strconv.Itoa(subscriber.Sent)},
+			{"opened", strconv.Itoa(subscriber.Opened)},
+			{"clicked", strconv.Itoa(subscriber.Clicked)},
+			{"date_created", formatTimestamp(&subscriber.DateCreated)},
+			{"date_subscribe", formatTimestamp(subscriber.DateSubscribe)},
+			{"date_unsubscribe", formatTimestamp(subscriber.DateUnsubscribe)},
+			{"date_updated", formatTimestamp(subscriber.DateUpdated)},
+		},
+	}
+
+	for _, field := range subscriber.Fields {
+		res.rows = append(res.rows, []string{"fields." + field.Key, field.Value.Text()})
+	}
+
+	return res
+}
+
+func groupsResult(groups []mailerlite.Group) *result {
+	res := &result{
+		value:   groups,
+		columns: []string{"id", "name", "total", "active", "unsubscribed", "bounced", "date_created"},
+	}
+
+	for _, group := range groups {
+		group := group
+
+		res.rows = append(res.rows, []string{
+			strconv.Itoa(group.ID),
+			group.Name,
+			strconv.Itoa(group.Total),
+			strconv.Itoa(group.Active),
+			strconv.Itoa(group.Unsubscribed),
+			strconv.Itoa(group.Bounced),
+			formatTimestamp(&group.DateCreated),
+		})
+	}
+
+	return res
+}
+
+func fieldsResult(value interface{}, fields []mailerlite.Field) *result {
+	res := &result{
+		value:   value,
+		columns: []string{"id", "key", "title", "type", "date_created"},
+	}
+
+	for _, field := range fields {
+		field := field
+
+		res.rows = append(res.rows, []string{
+			strconv.Itoa(int(field.ID)),
+			string(field.Key),
+			string(field.Title),
+			string(field.Type),
+			formatTimestamp(&field.DateCreated),
+		})
+	}
+
+	return res
+}
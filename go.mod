@@ -2,4 +2,9 @@ module github.com/sagikazarmark/go-mailerlite
 
 go 1.18
 
-require github.com/google/go-querystring v1.1.0
+require (
+	github.com/google/go-querystring v1.1.0
+	golang.org/x/net v0.19.0
+)
+
+require golang.org/x/text v0.14.0 // indirect
@@ -0,0 +1,157 @@
+package mailerlite
+
+import (
+	"context"
+	"time"
+)
+
+// API is the interface of Client, grouping the interfaces of its services.
+// Depend on API (or on the interface of a single service) instead of Client
+// to substitute fakes (eg. from the mailerlitemock package) in tests.
+type API interface {
+	CampaignsAPI() CampaignsAPI
+	AutomationsAPI() AutomationsAPI
+	FormsAPI() FormsAPI
+	SubscribersAPI() SubscribersAPI
+	GroupsAPI() GroupsAPI
+	FieldsAPI() FieldsAPI
+	WebhooksAPI() WebhooksAPI
+	StatsAPI() StatsAPI
+	SuppressionsAPI() SuppressionsAPI
+}
+
+var (
+	_ API = (*Client)(nil)
+
+	_ CampaignsAPI    = (*CampaignsService)(nil)
+	_ AutomationsAPI  = (*AutomationsService)(nil)
+	_ FormsAPI        = (*FormsService)(nil)
+	_ SubscribersAPI  = (*SubscribersService)(nil)
+	_ GroupsAPI       = (*GroupsService)(nil)
+	_ FieldsAPI       = (*FieldsService)(nil)
+	_ WebhooksAPI     = (*WebhooksService)(nil)
+	_ StatsAPI        = (*StatsService)(nil)
+	_ SuppressionsAPI = (*SuppressionsService)(nil)
+)
+
+// CampaignsAPI is the interface of CampaignsService.
+type CampaignsAPI interface {
+	List(ctx context.Context, status CampaignStatus, opts *CampaignListOptions, callOpts ...CallOption) ([]Campaign, *Response, error)
+	Report(ctx context.Context, id int, callOpts ...CallOption) (*CampaignReport, *Response, error)
+	Activity(ctx context.Context, id int, opts *CampaignActivityListOptions, callOpts ...CallOption) ([]CampaignActivity, *Response, error)
+}
+
+// AutomationsAPI is the interface of AutomationsService.
+type AutomationsAPI interface {
+	List(ctx context.Context, opts *AutomationListOptions, callOpts ...CallOption) ([]Automation, *Response, error)
+	Get(ctx context.Context, id int, callOpts ...CallOption) (*Automation, *Response, error)
+	Activity(ctx context.Context, id int, opts *AutomationActivityListOptions, callOpts ...CallOption) ([]AutomationActivity, *Response, error)
+}
+
+// FormsAPI is the interface of FormsService.
+type FormsAPI interface {
+	List(ctx context.Context, typ FormType, opts *FormListOptions, callOpts ...CallOption) ([]Form, *Response, error)
+	Get(ctx context.Context, id int, callOpts ...CallOption) (*Form, *Response, error)
+	Subscribers(ctx context.Context, id int, opts *FormSubscriberListOptions, callOpts ...CallOption) ([]FormSubscriber, *Response, error)
+	SubscribersEach(ctx context.Context, id int, opts *FormSubscriberListOptions, fn func(subscriber FormSubscriber) error, callOpts ...CallOption) (*Response, error)
+}
+
+// SubscribersAPI is the interface of SubscribersService.
+type SubscribersAPI interface {
+	List(ctx context.Context, opts *SubscriberListOptions, callOpts ...CallOption) ([]Subscriber, *Response, error)
+	ListEach(ctx context.Context, opts *SubscriberListOptions, fn func(subscriber Subscriber) error, callOpts ...CallOption) (*Response, error)
+	Search(ctx context.Context, query string, opts *SubscriberSearchOptions, callOpts ...CallOption) ([]Subscriber, *Response, error)
+	Create(ctx context.Context, newSubscriber NewSubscriber, callOpts ...CallOption) (*Subscriber, *Response, error)
+	Get(ctx context.Context, email string, callOpts ...CallOption) (*Subscriber, *Response, error)
+	Update(ctx context.Context, email string, update SubscriberUpdate, callOpts ...CallOption) (*Subscriber, *Response, error)
+	Groups(ctx context.Context, email string, callOpts ...CallOption) ([]Group, *Response, error)
+	Upsert(ctx context.Context, email string, data SubscriberUpsert, callOpts ...CallOption) (*UpsertResult, *Response, error)
+}
+
+// GroupsAPI is the interface of GroupsService.
+type GroupsAPI interface {
+	List(ctx context.Context, opts *GroupListOptions, callOpts ...CallOption) ([]Group, *Response, error)
+	Subscribers(ctx context.Context, id int, opts *GroupSubscriberListOptions, callOpts ...CallOption) ([]Subscriber, *Response, error)
+	SubscribersEach(ctx context.Context, id int, opts *GroupSubscriberListOptions, fn func(subscriber Subscriber) error, callOpts ...CallOption) (*Response, error)
+	AddSubscriber(ctx context.Context, id int, newSubscriber NewSubscriberInGroup, callOpts ...CallOption) (*Subscriber, *Response, error)
+	RemoveSubscriber(ctx context.Context, id int, email string, callOpts ...CallOption) (*Response, error)
+}
+
+// FieldsAPI is the interface of FieldsService.
+type FieldsAPI interface {
+	List(ctx context.Context, callOpts ...CallOption) ([]Field, *Response, error)
+	Create(ctx context.Context, newField NewField, callOpts ...CallOption) (*Field, *Response, error)
+	Update(ctx context.Context, id int, update FieldUpdate, callOpts ...CallOption) (*Field, *Response, error)
+	Delete(ctx context.Context, id int, callOpts ...CallOption) (*Response, error)
+	Plan(ctx context.Context, definitions []FieldDefinition, opts *FieldPlanOptions, callOpts ...CallOption) (*FieldPlan, *Response, error)
+	Apply(ctx context.Context, plan *FieldPlan, callOpts ...CallOption) (*Response, error)
+}
+
+// WebhooksAPI is the interface of WebhooksService.
+type WebhooksAPI interface {
+	List(ctx context.Context, callOpts ...CallOption) ([]Webhook, *Response, error)
+}
+
+// StatsAPI is the interface of StatsService.
+type StatsAPI interface {
+	Get(ctx context.Context, opts *StatGetOptions, callOpts ...CallOption) (*Stats, *Response, error)
+	History(ctx context.Context, from time.Time, to time.Time, step time.Duration, callOpts ...CallOption) (*StatsSeries, error)
+}
+
+// SuppressionsAPI is the interface of SuppressionsService.
+type SuppressionsAPI interface {
+	List(ctx context.Context, opts *SuppressionListOptions, callOpts ...CallOption) ([]Suppression, *Response, error)
+	ListEach(ctx context.Context, opts *SuppressionListOptions, fn func(suppression Suppression) error, callOpts ...CallOption) (*Response, error)
+	Add(ctx context.Context, emails []string, callOpts ...CallOption) ([]Suppression, *Response, error)
+	Remove(ctx context.Context, emails []string, callOpts ...CallOption) ([]string, *Response, error)
+	ListDomain(ctx context.Context, domain string, callOpts ...CallOption) ([]Suppression, error)
+	AddDomain(ctx context.Context, domain string, callOpts ...CallOption) ([]Suppression, *Response, error)
+	RemoveDomain(ctx context.Context, domain string, callOpts ...CallOption) ([]string, *Response, error)
+	Load(ctx context.Context, callOpts ...CallOption) (*SuppressionList, error)
+	Check(ctx context.Context, emails []string, callOpts ...CallOption) ([]string, error)
+}
+
+// CampaignsAPI returns the Campaigns service as an interface.
+func (c *Client) CampaignsAPI() CampaignsAPI {
+	return c.Campaigns
+}
+
+// AutomationsAPI returns the Automations service as an interface.
+func (c *Client) AutomationsAPI() AutomationsAPI {
+	return c.Automations
+}
+
+// FormsAPI returns the Forms service as an interface.
+func (c *Client) FormsAPI() FormsAPI {
+	return c.Forms
+}
+
+// SubscribersAPI returns the Subscribers service as an interface.
+func (c *Client) SubscribersAPI() SubscribersAPI {
+	return c.Subscribers
+}
+
+// GroupsAPI returns the Groups service as an interface.
+func (c *Client) GroupsAPI() GroupsAPI {
+	return c.Groups
+}
+
+// FieldsAPI returns the Fields service as an interface.
+func (c *Client) FieldsAPI() FieldsAPI {
+	return c.Fields
+}
+
+// WebhooksAPI returns the Webhooks service as an interface.
+func (c *Client) WebhooksAPI() WebhooksAPI {
+	return c.Webhooks
+}
+
+// StatsAPI returns the Stats service as an interface.
+func (c *Client) StatsAPI() StatsAPI {
+	return c.Stats
+}
+
+// SuppressionsAPI returns the Suppressions service as an interface.
+func (c *Client) SuppressionsAPI() SuppressionsAPI {
+	return c.Suppressions
+}
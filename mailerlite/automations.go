@@ -0,0 +1,266 @@
+package mailerlite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// AutomationsService handles communication with the automation related
+// methods of the MailerLite API.
+//
+// Automations are only available in the Connect API (see ConnectBaseURL).
+//
+// MailerLite API docs: https://developers.mailerlite.com/docs/automations.html
+type AutomationsService service
+
+// Automation represents an automation workflow.
+type Automation struct {
+	ID          WeakInt             `json:"id"`
+	Name        string              `json:"name"`
+	Enabled     WeakBool            `json:"enabled"`
+	Triggers    []AutomationTrigger `json:"triggers"`
+	Steps       []AutomationStep    `json:"steps"`
+	Complete    WeakBool            `json:"complete"`
+	Broken      WeakBool            `json:"broken"`
+	EmailsCount int                 `json:"emails_count"`
+	Stats       AutomationStats     `json:"stats"`
+	CreatedAt   Timestamp           `json:"created_at"`
+
+	Raw json.RawMessage `json:"-"` // Raw JSON of the response, see RetainRaw.
+}
+
+// AutomationTrigger describes what starts an automation for a subscriber.
+type AutomationTrigger struct {
+	ID   WeakInt               `json:"id"`
+	Type AutomationTriggerType `json:"type"`
+
+	// Group the subscriber joins (for TriggerGroupJoined).
+	GroupID *WeakInt `json:"group_id"`
+
+	Broken WeakBool `json:"broken"`
+}
+
+// AutomationTriggerType represents the kind of event starting an automation.
+type AutomationTriggerType string
+
+const (
+	TriggerGroupJoined    AutomationTriggerType = "subscriber_joins_group"
+	TriggerFieldUpdated   AutomationTriggerType = "subscriber_updates_field"
+	TriggerDate           AutomationTriggerType = "date_anniversary"
+	TriggerLinkClicked    AutomationTriggerType = "link_clicked"
+	TriggerCampaignOpened AutomationTriggerType = "campaign_opened"
+)
+
+// AutomationStep is a single step of an automation.
+type AutomationStep struct {
+	ID   WeakInt            `json:"id"`
+	Type AutomationStepType `json:"type"`
+	Name string             `json:"name"`
+
+	// Subject of the email (for StepEmail).
+	Subject string `json:"subject"`
+
+	// Delay before the next step (for StepDelay).
+	Unit        string `json:"unit"`
+	Value       string `json:"value"`
+	Description string `json:"description"`
+
+	// Statistics of the email sent by the step (for StepEmail).
+	Stats *AutomationStepStats `json:"stats"`
+}
+
+// AutomationStepStats are the statistics of the email sent by an automation step.
+type AutomationStepStats struct {
+	Sent              int `json:"sent"`
+	OpensCount        int `json:"opens_count"`
+	UniqueOpensCount  int `json:"unique_opens_count"`
+	ClicksCount       int `json:"clicks_count"`
+	UniqueClicksCount int `json:"unique_clicks_count"`
+	UnsubscribesCount int `json:"unsubscribes_count"`
+	SpamCount         int `json:"spam_count"`
+	HardBouncesCount  int `json:"hard_bounces_count"`
+	SoftBouncesCount  int `json:"soft_bounces_count"`
+
+	OpenRate        Ratio `json:"open_rate"`
+	ClickRate       Ratio `json:"click_rate"`
+	ClickToOpenRate Ratio `json:"click_to_open_rate"`
+	UnsubscribeRate Ratio `json:"unsubscribe_rate"`
+}
+
+// AutomationStepType represents the kind of an automation step.
+type AutomationStepType string
+
+const (
+	StepEmail     AutomationStepType = "email"
+	StepDelay     AutomationStepType = "delay"
+	StepCondition AutomationStepType = "condition"
+	StepAction    AutomationStepType = "action"
+)
+
+// AutomationStats are the statistics of the emails sent by an automation.
+type AutomationStats struct {
+	CompletedSubscribersCount int `json:"completed_subscribers_count"`
+	SubscribersInQueueCount   int `json:"subscribers_in_queue_count"`
+	Sent                      int `json:"sent"`
+	OpensCount                int `json:"opens_count"`
+	UniqueOpensCount          int `json:"unique_opens_count"`
+	ClicksCount               int `json:"clicks_count"`
+	UniqueClicksCount         int `json:"unique_clicks_count"`
+	UnsubscribesCount         int `json:"unsubscribes_count"`
+	SpamCount                 int `json:"spam_count"`
+	HardBouncesCount          int `json:"hard_bounces_count"`
+	SoftBouncesCount          int `json:"soft_bounces_count"`
+
+	OpenRate        Ratio `json:"open_rate"`
+	ClickRate       Ratio `json:"click_rate"`
+	ClickToOpenRate Ratio `json:"click_to_open_rate"`
+	UnsubscribeRate Ratio `json:"unsubscribe_rate"`
+	SpamRate        Ratio `json:"spam_rate"`
+	BounceRate      Ratio `json:"bounce_rate"`
+	HardBounceRate  Ratio `json:"hard_bounce_rate"`
+	SoftBounceRate  Ratio `json:"soft_bounce_rate"`
+}
+
+// AutomationActivity represents the progress of a subscriber in an automation.
+type AutomationActivity struct {
+	ID                WeakInt                      `json:"id"`
+	Status            AutomationActivityStatus     `json:"status"`
+	Date              *Timestamp                   `json:"date"`
+	Reason            string                       `json:"reason"`
+	ReasonDescription string                       `json:"reason_description"`
+	Subscriber        AutomationActivitySubscriber `json:"subscriber"`
+
+	Raw json.RawMessage `json:"-"` // Raw JSON of the response, see RetainRaw.
+}
+
+// AutomationActivitySubscriber identifies the subscriber going through an automation.
+type AutomationActivitySubscriber struct {
+	ID    WeakInt `json:"id"`
+	Email string  `json:"email"`
+}
+
+// AutomationActivityStatus represents the state of a subscriber in an automation.
+type AutomationActivityStatus string
+
+const (
+	AutomationActive    AutomationActivityStatus = "active"
+	AutomationCompleted AutomationActivityStatus = "completed"
+	AutomationCanceled  AutomationActivityStatus = "canceled"
+	AutomationFailed    AutomationActivityStatus = "failed"
+)
+
+// AutomationListOptions specifies the optional parameters to the
+// AutomationsService.List method.
+type AutomationListOptions struct {
+	// List only enabled (true) or disabled (false) automations.
+	Enabled *bool `url:"filter[enabled],omitempty"`
+
+	// List only automations whose name contains Name.
+	Name string `url:"filter[name],omitempty"`
+
+	// List only automations triggered by joining a group.
+	Group int `url:"filter[group],omitempty"`
+
+	PageOptions
+}
+
+// List all automations.
+//
+// MailerLite API docs: https://developers.mailerlite.com/docs/automations.html#list-all-automations
+func (s *AutomationsService) List(ctx context.Context, opts *AutomationListOptions, callOpts ...CallOption) ([]Automation, *Response, error) {
+	ctx = withOperation(ctx, "AutomationsService.List")
+
+	u := "automations"
+
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newConnectRequest(ctx, http.MethodGet, u, nil, callOpts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	automations, resp, err := connectDo[[]Automation](s.client, req)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return automations, resp, nil
+}
+
+// Get fetches an automation.
+//
+// MailerLite API docs: https://developers.mailerlite.com/docs/automations.html#get-an-automation
+func (s *AutomationsService) Get(ctx context.Context, id int, callOpts ...CallOption) (*Automation, *Response, error) {
+	ctx = withOperation(ctx, "AutomationsService.Get")
+
+	u := fmt.Sprintf("automations/%d", id)
+
+	req, err := s.client.newConnectRequest(ctx, http.MethodGet, u, nil, callOpts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	automation, resp, err := connectDo[*Automation](s.client, req)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return automation, resp, nil
+}
+
+// AutomationActivityListOptions specifies the parameters to the
+// AutomationsService.Activity method.
+type AutomationActivityListOptions struct {
+	// Status of the subscribers to list (required by the API, defaults to AutomationActive).
+	Status AutomationActivityStatus `url:"filter[status]"`
+
+	// List only activity between the dates (YYYY-MM-DD).
+	DateFrom string `url:"filter[date_from],omitempty"`
+	DateTo   string `url:"filter[date_to],omitempty"`
+
+	// Search subscribers by email address.
+	Keyword string `url:"filter[keyword],omitempty"`
+
+	PageOptions
+}
+
+// Activity lists the subscribers going through an automation.
+//
+// MailerLite API docs: https://developers.mailerlite.com/docs/automations.html#get-the-subscriber-activity-for-an-automation
+func (s *AutomationsService) Activity(ctx context.Context, id int, opts *AutomationActivityListOptions, callOpts ...CallOption) ([]AutomationActivity, *Response, error) {
+	ctx = withOperation(ctx, "AutomationsService.Activity")
+
+	if opts == nil {
+		opts = &AutomationActivityListOptions{}
+	}
+
+	if opts.Status == "" {
+		o := *opts
+		o.Status = AutomationActive
+		opts = &o
+	}
+
+	u := fmt.Sprintf("automations/%d/activity", id)
+
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newConnectRequest(ctx, http.MethodGet, u, nil, callOpts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	activity, resp, err := connectDo[[]AutomationActivity](s.client, req)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return activity, resp, nil
+}
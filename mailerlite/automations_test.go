@@ -0,0 +1,90 @@
+package mailerlite
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAutomationsService_List(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/automations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		if got, want := r.URL.Query().Get("filter[enabled]"), "true"; got != want {
+			t.Errorf("enabled filter: got %q, want %q", got, want)
+		}
+
+		_, _ = w.Write([]byte(`{
+			"data": [{
+				"id": "1",
+				"name": "Onboarding",
+				"enabled": true,
+				"triggers": [{"id": "2", "type": "subscriber_joins_group", "group_id": "3"}],
+				"steps": [
+					{"id": "4", "type": "email", "subject": "Welcome!", "stats": {"sent": 10, "opens_count": 3, "clicks_count": 1, "open_rate": {"float": 0.3, "string": "30%"}}},
+					{"id": "5", "type": "delay", "unit": "days", "value": "1"}
+				],
+				"stats": {"sent": 10, "open_rate": {"float": 0.2, "string": "20%"}},
+				"created_at": "2022-01-01 10:00:00"
+			}],
+			"links": {},
+			"meta": {}
+		}`))
+	})
+
+	enabled := true
+
+	automations, _, err := client.Automations.List(context.Background(), &AutomationListOptions{Enabled: &enabled})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(automations) != 1 {
+		t.Fatalf("expected 1 automation, got %d", len(automations))
+	}
+
+	automation := automations[0]
+
+	if automation.ID != 1 || automation.Triggers[0].GroupID == nil || *automation.Triggers[0].GroupID != 3 || automation.Stats.Sent != 10 {
+		t.Errorf("unexpected automation: %+v", automation)
+	}
+
+	if len(automation.Steps) != 2 {
+		t.Fatalf("expected 2 steps, got %d", len(automation.Steps))
+	}
+
+	wantStats := &AutomationStepStats{Sent: 10, OpensCount: 3, ClicksCount: 1, OpenRate: Ratio{Float: 0.3, String: "30%"}}
+	if got := automation.Steps[0].Stats; !reflect.DeepEqual(got, wantStats) {
+		t.Errorf("email step stats: got %+v, want %+v", got, wantStats)
+	}
+
+	if got := automation.Steps[1].Stats; got != nil {
+		t.Errorf("delay step should have no stats, got %+v", got)
+	}
+}
+
+func TestAutomationsService_Activity(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/automations/1/activity", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		if got, want := r.URL.Query().Get("filter[status]"), "active"; got != want {
+			t.Errorf("status filter: got %q, want %q", got, want)
+		}
+
+		_, _ = w.Write([]byte(`{"data": [{"id": "5", "status": "active", "subscriber": {"id": "6", "email": "john@example.com"}}]}`))
+	})
+
+	activity, _, err := client.Automations.Activity(context.Background(), 1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(activity) != 1 || activity[0].Status != AutomationActive || activity[0].Subscriber.Email != "john@example.com" {
+		t.Errorf("unexpected activity: %+v", activity)
+	}
+}
@@ -0,0 +1,276 @@
+package mailerlite
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CacheStore stores cached API responses.
+//
+// Implementations must be safe for concurrent use.
+type CacheStore interface {
+	// Get returns the entry stored under key (if it exists and has not expired yet).
+	Get(key string) (CacheEntry, bool)
+
+	// Set stores an entry under key for the duration of ttl.
+	Set(key string, entry CacheEntry, ttl time.Duration)
+
+	// DeletePrefix removes every entry whose key starts with prefix.
+	DeletePrefix(prefix string)
+}
+
+// CacheEntry is a cached API response.
+type CacheEntry struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// ResponseCache configures a Client to cache responses of GET requests sent to the listed resources
+// (the first path segment of the endpoint, eg. "fields").
+// Defaults to caching fields, groups and stats.
+//
+// Cache entries are keyed by the request method, the URL and the account (API key) sending the request.
+// Entries of a resource are invalidated when the same Client sends a mutating request to that resource
+// or to a resource it depends on (eg. FieldsService.Create invalidates the cached field list,
+// SubscribersService.Update invalidates cached group subscribers and stats as well).
+//
+// Changes made by other clients, the MailerLite dashboard or automations are not noticed:
+// such changes become visible when the entries expire after ttl.
+//
+// Only requests sent through Client.Do are cached (Stream and StreamTo always send a request).
+// Requests sent to the Connect API (see ConnectBaseURL) are neither cached nor invalidate cached entries.
+// Cache hits are flagged by Response.FromCache and reported to observers with CallResult.FromCache set.
+func ResponseCache(store CacheStore, ttl time.Duration, resources ...string) ClientOption {
+	return clientOptionFunc(func(c *Client) {
+		if store == nil {
+			return
+		}
+
+		if len(resources) == 0 {
+			resources = []string{"fields", "groups", "stats"}
+		}
+
+		cache := &responseCache{
+			store:     store,
+			ttl:       ttl,
+			resources: make(map[string]bool, len(resources)),
+		}
+
+		for _, resource := range resources {
+			cache.resources[resource] = true
+		}
+
+		c.cache = cache
+	})
+}
+
+// cacheDependents lists the resources whose responses may change when a resource is mutated.
+var cacheDependents = map[string][]string{
+	"subscribers": {"groups", "segments", "stats"},
+	"groups":      {"subscribers", "stats"},
+	"fields":      {"subscribers", "groups"},
+	"campaigns":   {"stats"},
+}
+
+type responseCache struct {
+	store     CacheStore
+	ttl       time.Duration
+	resources map[string]bool
+
+	// account identifies the API key without storing it in cache keys.
+	account string
+}
+
+// cachedDo sends an API request unless a cached response is available for it.
+// Cacheable responses are read into memory, so the returned response body is always safe to read.
+func (c *Client) cachedDo(req *http.Request) (*Response, error) {
+	if c.cache == nil {
+		return c.BareDo(req)
+	}
+
+	resource := c.resourceOf(req)
+
+	if req.Method != http.MethodGet {
+		if req.Method != http.MethodHead && resource != "" {
+			defer c.cache.invalidate(resource)
+		}
+
+		return c.BareDo(req)
+	}
+
+	if !c.cache.resources[resource] {
+		return c.BareDo(req)
+	}
+
+	key := c.cache.prefix(resource) + req.Method + " " + req.URL.String()
+
+	if entry, ok := c.cache.store.Get(key); ok {
+		return c.observe(req, func(req *http.Request) (*Response, int, error) {
+			resp := &http.Response{
+				Status:        http.StatusText(entry.StatusCode),
+				StatusCode:    entry.StatusCode,
+				Header:        entry.Header.Clone(),
+				Body:          io.NopCloser(bytes.NewReader(entry.Body)),
+				ContentLength: int64(len(entry.Body)),
+				Request:       req,
+			}
+
+			return &Response{Response: resp, Rate: parseRate(resp), FromCache: true}, 0, nil
+		})
+	}
+
+	resp, err := c.BareDo(req)
+	if err != nil {
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return resp, err
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	c.cache.store.Set(key, CacheEntry{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		Body:       body,
+	}, c.cache.ttl)
+
+	return resp, nil
+}
+
+// resourceOf returns the first path segment of a request URL relative to the base URL.
+func (c *Client) resourceOf(req *http.Request) string {
+	if req.URL.Host != c.baseURL.Host || !strings.HasPrefix(req.URL.Path, c.baseURL.Path) {
+		return ""
+	}
+
+	resource := strings.TrimPrefix(req.URL.Path, c.baseURL.Path)
+	if i := strings.Index(resource, "/"); i >= 0 {
+		resource = resource[:i]
+	}
+
+	return resource
+}
+
+// invalidate removes the cached entries of a resource and the resources depending on it.
+func (c *responseCache) invalidate(resource string) {
+	c.store.DeletePrefix(c.prefix(resource))
+
+	for _, dependent := range cacheDependents[resource] {
+		c.store.DeletePrefix(c.prefix(dependent))
+	}
+}
+
+func (c *responseCache) prefix(resource string) string {
+	return c.account + "/" + resource + "/"
+}
+
+// cacheAccount derives a stable account identifier from an API key.
+func cacheAccount(apiKey string) string {
+	sum := sha256.Sum256([]byte(apiKey))
+
+	return hex.EncodeToString(sum[:8])
+}
+
+// MemoryCache is an in-memory, size-bounded CacheStore evicting the least recently used entries.
+type MemoryCache struct {
+	mu      sync.Mutex
+	size    int
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+type memoryCacheItem struct {
+	key       string
+	entry     CacheEntry
+	expiresAt time.Time
+}
+
+// NewMemoryCache returns a new MemoryCache holding at most size entries.
+func NewMemoryCache(size int) *MemoryCache {
+	return &MemoryCache{
+		size:    size,
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// Get implements the CacheStore interface.
+func (c *MemoryCache) Get(key string) (CacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return CacheEntry{}, false
+	}
+
+	item := elem.Value.(*memoryCacheItem)
+
+	if !item.expiresAt.IsZero() && time.Now().After(item.expiresAt) {
+		c.remove(elem)
+
+		return CacheEntry{}, false
+	}
+
+	c.lru.MoveToFront(elem)
+
+	return item.entry, true
+}
+
+// Set implements the CacheStore interface.
+// Entries with a non-positive ttl never expire (but may still be evicted).
+func (c *MemoryCache) Set(key string, entry CacheEntry, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	item := &memoryCacheItem{
+		key:   key,
+		entry: entry,
+	}
+
+	if ttl > 0 {
+		item.expiresAt = time.Now().Add(ttl)
+	}
+
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = item
+		c.lru.MoveToFront(elem)
+
+		return
+	}
+
+	c.entries[key] = c.lru.PushFront(item)
+
+	for c.size > 0 && c.lru.Len() > c.size {
+		c.remove(c.lru.Back())
+	}
+}
+
+// DeletePrefix implements the CacheStore interface.
+func (c *MemoryCache) DeletePrefix(prefix string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, elem := range c.entries {
+		if strings.HasPrefix(key, prefix) {
+			c.remove(elem)
+		}
+	}
+}
+
+func (c *MemoryCache) remove(elem *list.Element) {
+	c.lru.Remove(elem)
+	delete(c.entries, elem.Value.(*memoryCacheItem).key)
+}
@@ -0,0 +1,147 @@
+package mailerlite
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestResponseCache(t *testing.T) {
+	metrics := NewMemoryMetrics()
+	tracer := NewMemoryTracer()
+
+	client, mux := setup(t,
+		ResponseCache(NewMemoryCache(10), time.Minute),
+		Instrument(NewMetricsObserver(metrics), NewTracingObserver(tracer)),
+	)
+
+	var requests int
+	mux.HandleFunc("/fields", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		_, _ = w.Write([]byte(`[{"id":1,"title":"Email","key":"email","type":"TEXT"}]`))
+	})
+
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		fields, resp, err := client.Fields.List(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(fields) != 1 || fields[0].Key != "email" {
+			t.Errorf("unexpected fields: %+v", fields)
+		}
+
+		if want := i > 0; resp.FromCache != want {
+			t.Errorf("response %d: FromCache is %t, want %t", i, resp.FromCache, want)
+		}
+	}
+
+	if requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+
+	const operation = "FieldsService.List"
+
+	if got := metrics.CacheHits(operation); got != 1 {
+		t.Errorf("cache hits: got %d, want 1", got)
+	}
+
+	if got := len(metrics.Latencies(operation)); got != 1 {
+		t.Errorf("cache hits should not be recorded as latencies, got %d observations", got)
+	}
+
+	spans := tracer.Spans()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+
+	if spans[1].Attributes["mailerlite.cache_hit"] != true {
+		t.Errorf("expected the second span to be a cache hit: %v", spans[1].Attributes)
+	}
+}
+
+func TestResponseCache_InvalidatesDependents(t *testing.T) {
+	client, mux := setup(t, ResponseCache(NewMemoryCache(10), time.Minute))
+
+	var requests int
+	mux.HandleFunc("/groups/1/subscribers", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		_, _ = w.Write([]byte(`[{"id":1,"email":"john@example.com"}]`))
+	})
+	mux.HandleFunc("/subscribers/john@example.com", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":1,"email":"john@example.com","name":"John"}`))
+	})
+
+	ctx := context.Background()
+
+	list := func() {
+		t.Helper()
+
+		if _, _, err := client.Groups.Subscribers(ctx, 1, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	list()
+	list()
+
+	if requests != 1 {
+		t.Fatalf("expected the group subscribers to be cached, got %d requests", requests)
+	}
+
+	if _, _, err := client.Subscribers.Update(ctx, "john@example.com", SubscriberUpdate{Name: "John"}); err != nil {
+		t.Fatal(err)
+	}
+
+	list()
+
+	if requests != 2 {
+		t.Errorf("expected updating a subscriber to invalidate the group subscribers, got %d requests", requests)
+	}
+}
+
+func TestResponseCache_Stream(t *testing.T) {
+	client, mux := setup(t, ResponseCache(NewMemoryCache(10), time.Minute))
+
+	var requests int
+	mux.HandleFunc("/groups", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		_, _ = w.Write([]byte(`[{"id":1,"name":"Customers"},{"id":2,"name":"Leads"}]`))
+	})
+
+	for i := 0; i < 2; i++ {
+		req, err := client.NewRequest(context.Background(), http.MethodGet, "groups", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var groups []Group
+
+		resp, err := Stream(client, req, func(group Group) error {
+			groups = append(groups, group)
+
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if resp.FromCache {
+			t.Error("streamed response should not be served from the cache")
+		}
+
+		if len(groups) != 2 {
+			t.Errorf("expected 2 groups, got %d", len(groups))
+		}
+	}
+
+	if requests != 2 {
+		t.Errorf("expected every stream to send a request, got %d requests", requests)
+	}
+}
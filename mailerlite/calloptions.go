@@ -0,0 +1,133 @@
+package mailerlite
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"time"
+)
+
+const headerIdempotencyKey = "Idempotency-Key"
+
+// CallOption configures a single API call.
+// Every service method accepts call options as its last arguments.
+//
+// Methods sending multiple requests (eg. SubscribersService.Upsert or StatsService.History)
+// apply the options to each request, possibly concurrently.
+// An idempotency key passed to them is suffixed with the sequence number of each request (eg. "key-1", "key-2").
+type CallOption interface {
+	applyCall(o *callOptions)
+}
+
+type callOptionFunc func(o *callOptions)
+
+func (fn callOptionFunc) applyCall(o *callOptions) {
+	fn(o)
+}
+
+// callOptions are the options of a single API call.
+type callOptions struct {
+	header         http.Header
+	timeout        time.Duration
+	baseURL        *url.URL
+	capture        io.Writer
+	noRetry        bool
+	idempotencyKey string
+}
+
+// WithHeader adds a header to the request.
+func WithHeader(key string, value string) CallOption {
+	return callOptionFunc(func(o *callOptions) {
+		if o.header == nil {
+			o.header = make(http.Header)
+		}
+
+		o.header.Add(key, value)
+	})
+}
+
+// WithTimeout limits the time the call (including retries and decoding the response) may take.
+// Requests sent with BareDo time out while the response body is being read as well, until it is closed.
+func WithTimeout(timeout time.Duration) CallOption {
+	return callOptionFunc(func(o *callOptions) {
+		o.timeout = timeout
+	})
+}
+
+// WithIdempotencyKey sets the Idempotency-Key header of the request.
+// Requests with an idempotency key are retried like idempotent requests, even if their method is not idempotent.
+func WithIdempotencyKey(key string) CallOption {
+	return callOptionFunc(func(o *callOptions) {
+		o.idempotencyKey = key
+	})
+}
+
+// WithBodyCapture writes the raw body of the response (including error responses) to w as it is read.
+// Streams stopped early with ErrStopStream capture the body only up to where decoding stopped.
+func WithBodyCapture(w io.Writer) CallOption {
+	return callOptionFunc(func(o *callOptions) {
+		o.capture = w
+	})
+}
+
+// WithoutRetry disables retrying the request (see MaxRetries).
+func WithoutRetry() CallOption {
+	return callOptionFunc(func(o *callOptions) {
+		o.noRetry = true
+	})
+}
+
+// WithBaseURL sends the request to a different base URL than the one of the Client.
+// The base URL should always be specified with a trailing slash.
+func WithBaseURL(baseURL *url.URL) CallOption {
+	return callOptionFunc(func(o *callOptions) {
+		o.baseURL = baseURL
+	})
+}
+
+// nthCallOptions returns the call options of the nth request of a method sending multiple requests:
+// the idempotency key of the call (if any) is suffixed with n, so each request has a key of its own.
+func nthCallOptions(opts []CallOption, n int) []CallOption {
+	return append(opts[:len(opts):len(opts)], callOptionFunc(func(o *callOptions) {
+		o.sequence(n)
+	}))
+}
+
+// sequenceCallOptions returns the call options of a method sending multiple requests one after the other:
+// the idempotency key of the call (if any) is suffixed with the sequence number of each request created with them.
+func sequenceCallOptions(opts []CallOption) []CallOption {
+	var n int32
+
+	return append(opts[:len(opts):len(opts)], callOptionFunc(func(o *callOptions) {
+		o.sequence(int(atomic.AddInt32(&n, 1)))
+	}))
+}
+
+func (o *callOptions) sequence(n int) {
+	if o.idempotencyKey != "" {
+		o.idempotencyKey = fmt.Sprintf("%s-%d", o.idempotencyKey, n)
+	}
+}
+
+type callOptionsContextKey struct{}
+
+func newCallOptions(opts []CallOption) *callOptions {
+	o := &callOptions{}
+	for _, opt := range opts {
+		opt.applyCall(o)
+	}
+
+	return o
+}
+
+// callOptionsFromContext returns the options of the call a request context belongs to.
+func callOptionsFromContext(ctx context.Context) *callOptions {
+	if o, ok := ctx.Value(callOptionsContextKey{}).(*callOptions); ok {
+		return o
+	}
+
+	return &callOptions{}
+}
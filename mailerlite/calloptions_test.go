@@ -0,0 +1,148 @@
+package mailerlite
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestWithHeader(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/stats", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.Header.Values("X-Trace"), []string{"a", "b"}; len(got) != 2 || got[0] != want[0] || got[1] != want[1] {
+			t.Errorf("header: got %v, want %v", got, want)
+		}
+
+		if got, want := r.Header.Get(headerAPIKey), "api-key"; got != want {
+			t.Errorf("api key header: got %q, want %q", got, want)
+		}
+
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	_, _, err := client.Stats.Get(context.Background(), nil, WithHeader("X-Trace", "a"), WithHeader("X-Trace", "b"))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/stats", func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+
+	_, _, err := client.Stats.Get(context.Background(), nil, WithTimeout(20*time.Millisecond))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the call to time out, got %v", err)
+	}
+}
+
+func TestWithBodyCapture(t *testing.T) {
+	client, mux := setup(t)
+
+	const body = `{"subscribed": 10, "unknown": true}`
+
+	mux.HandleFunc("/stats", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(body))
+	})
+
+	mux.HandleFunc("/groups/1/subscribers", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":{"code":123,"message":"Group not found"}}`))
+	})
+
+	var capture bytes.Buffer
+
+	stats, _, err := client.Stats.Get(context.Background(), nil, WithBodyCapture(&capture))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if stats.Subscribed != 10 {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+
+	if got := capture.String(); got != body {
+		t.Errorf("captured body: got %q, want %q", got, body)
+	}
+
+	capture.Reset()
+
+	_, _, err = client.Groups.Subscribers(context.Background(), 1, nil, WithBodyCapture(&capture))
+	if !IsNotFound(err) {
+		t.Fatalf("expected a not found error, got %v", err)
+	}
+
+	if got, want := capture.String(), `{"error":{"code":123,"message":"Group not found"}}`; got != want {
+		t.Errorf("captured error body: got %q, want %q", got, want)
+	}
+}
+
+func TestWithoutRetry(t *testing.T) {
+	client, mux := setup(t, MaxRetries(2))
+
+	var attempts int
+
+	mux.HandleFunc("/stats", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, _, err := client.Stats.Get(context.Background(), nil)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if attempts != 3 {
+		t.Fatalf("MaxRetries(2) should send 3 attempts, got %d", attempts)
+	}
+
+	attempts = 0
+
+	_, _, err = client.Stats.Get(context.Background(), nil, WithoutRetry())
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if attempts != 1 {
+		t.Errorf("WithoutRetry should send a single attempt, got %d", attempts)
+	}
+}
+
+func TestWithBaseURL(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/stats", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request sent to the base URL of the client")
+	})
+
+	other := http.NewServeMux()
+
+	server := httptest.NewServer(other)
+	t.Cleanup(server.Close)
+
+	other.HandleFunc("/v3/stats", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"subscribed": 5}`))
+	})
+
+	baseURL, _ := url.Parse(server.URL + "/v3/")
+
+	stats, _, err := client.Stats.Get(context.Background(), nil, WithBaseURL(baseURL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if stats.Subscribed != 5 {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+}
@@ -0,0 +1,144 @@
+package mailerlite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// CampaignReport summarizes the performance of a sent campaign.
+type CampaignReport struct {
+	ID    WeakInt       `json:"id"`
+	Name  string        `json:"name"`
+	Stats CampaignStats `json:"stats"`
+
+	Raw json.RawMessage `json:"-"` // Raw JSON of the response, see RetainRaw.
+}
+
+// CampaignStats are the delivery and engagement statistics of a campaign.
+type CampaignStats struct {
+	Sent              int `json:"sent"`
+	OpensCount        int `json:"opens_count"`
+	UniqueOpensCount  int `json:"unique_opens_count"`
+	ClicksCount       int `json:"clicks_count"`
+	UniqueClicksCount int `json:"unique_clicks_count"`
+	UnsubscribesCount int `json:"unsubscribes_count"`
+	SpamCount         int `json:"spam_count"`
+	HardBouncesCount  int `json:"hard_bounces_count"`
+	SoftBouncesCount  int `json:"soft_bounces_count"`
+	ForwardsCount     int `json:"forwards_count"`
+
+	OpenRate        Ratio `json:"open_rate"`
+	ClickRate       Ratio `json:"click_rate"`
+	ClickToOpenRate Ratio `json:"click_to_open_rate"`
+	UnsubscribeRate Ratio `json:"unsubscribe_rate"`
+	SpamRate        Ratio `json:"spam_rate"`
+	HardBounceRate  Ratio `json:"hard_bounce_rate"`
+	SoftBounceRate  Ratio `json:"soft_bounce_rate"`
+}
+
+// CampaignActivity is the activity of a single recipient of a campaign.
+type CampaignActivity struct {
+	ID          WeakInt                    `json:"id"`
+	OpensCount  int                        `json:"opens_count"`
+	ClicksCount int                        `json:"clicks_count"`
+	Subscriber  CampaignActivitySubscriber `json:"subscriber"`
+
+	// Links the recipient clicked, with the number of clicks on each.
+	Clicks []CampaignLinkClick `json:"clicks"`
+
+	Raw json.RawMessage `json:"-"` // Raw JSON of the response, see RetainRaw.
+}
+
+// CampaignLinkClick is the number of times a recipient clicked a link of a campaign.
+type CampaignLinkClick struct {
+	URL         string `json:"url"`
+	ClicksCount int    `json:"clicks_count"`
+}
+
+// CampaignActivitySubscriber identifies the recipient of a campaign.
+type CampaignActivitySubscriber struct {
+	ID    WeakInt `json:"id"`
+	Email string  `json:"email"`
+}
+
+// CampaignActivityType filters the recipients of a campaign by what they did.
+type CampaignActivityType string
+
+const (
+	CampaignOpened       CampaignActivityType = "opened"
+	CampaignUnopened     CampaignActivityType = "unopened"
+	CampaignClicked      CampaignActivityType = "clicked"
+	CampaignUnsubscribed CampaignActivityType = "unsubscribed"
+	CampaignForwarded    CampaignActivityType = "forwarded"
+	CampaignHardBounced  CampaignActivityType = "hardbounced"
+	CampaignSoftBounced  CampaignActivityType = "softbounced"
+	CampaignJunk         CampaignActivityType = "junk"
+)
+
+// CampaignActivityListOptions specifies the optional parameters to the
+// CampaignsService.Activity method.
+type CampaignActivityListOptions struct {
+	Type CampaignActivityType `url:"filter[type],omitempty"`
+
+	// Search recipients by email address.
+	Search string `url:"filter[search],omitempty"`
+
+	PageOptions
+}
+
+// Report returns the summary report of a campaign.
+//
+// Reports are served by the Connect API (see ConnectBaseURL), so id is the ID of the campaign in the Connect API,
+// which is not the ID returned by CampaignsService.List (API v2).
+//
+// MailerLite API docs: https://developers.mailerlite.com/docs/campaigns.html#get-a-campaign
+func (s *CampaignsService) Report(ctx context.Context, id int, callOpts ...CallOption) (*CampaignReport, *Response, error) {
+	ctx = withOperation(ctx, "CampaignsService.Report")
+
+	u := fmt.Sprintf("campaigns/%d", id)
+
+	req, err := s.client.newConnectRequest(ctx, http.MethodGet, u, nil, callOpts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	report, resp, err := connectDo[*CampaignReport](s.client, req)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return report, resp, nil
+}
+
+// Activity lists the recipients of a sent campaign with the number of times they opened it and clicked links in it.
+// Use CampaignActivityListOptions.Type to list the recipients who opened, clicked, unsubscribed, bounced or complained.
+// Recipients who clicked links list the clicked links with their counts in CampaignActivity.Clicks.
+//
+// Reports are served by the Connect API (see ConnectBaseURL), so id is the ID of the campaign in the Connect API,
+// which is not the ID returned by CampaignsService.List (API v2).
+//
+// MailerLite API docs: https://developers.mailerlite.com/docs/campaigns.html#get-subscribers-activity-of-a-sent-campaign
+func (s *CampaignsService) Activity(ctx context.Context, id int, opts *CampaignActivityListOptions, callOpts ...CallOption) ([]CampaignActivity, *Response, error) {
+	ctx = withOperation(ctx, "CampaignsService.Activity")
+
+	u := fmt.Sprintf("campaigns/%d/reports/subscriber-activity", id)
+
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newConnectRequest(ctx, http.MethodGet, u, nil, callOpts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	activity, resp, err := connectDo[[]CampaignActivity](s.client, req)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return activity, resp, nil
+}
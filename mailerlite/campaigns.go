@@ -1,7 +1,77 @@
 package mailerlite
 
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
 // CampaignsService handles communication with the campaign related
 // methods of the MailerLite API.
 //
 // MailerLite API docs: https://developers.mailerlite.com/reference/campaigns-by-type
 type CampaignsService service
+
+// Campaign represents an email campaign.
+type Campaign struct {
+	ID              int            `json:"id"`
+	Name            string         `json:"name"`
+	Type            string         `json:"type"`
+	Status          CampaignStatus `json:"status"`
+	TotalRecipients int            `json:"total_recipients"`
+	Opened          CampaignStat   `json:"opened"`
+	Clicked         CampaignStat   `json:"clicked"`
+	DateCreated     Timestamp      `json:"date_created"`
+	DateSend        *Timestamp     `json:"date_send"`
+
+	Raw json.RawMessage `json:"-"` // Raw JSON of the response, see RetainRaw.
+}
+
+// CampaignStat represents the count and the rate of an interaction with a campaign.
+type CampaignStat struct {
+	Count int     `json:"count"`
+	Rate  float64 `json:"rate"`
+}
+
+// CampaignStatus represents the state of a campaign.
+type CampaignStatus string
+
+const (
+	CampaignSent   CampaignStatus = "sent"
+	CampaignDraft  CampaignStatus = "draft"
+	CampaignOutbox CampaignStatus = "outbox"
+)
+
+// CampaignListOptions specifies the optional parameters to the
+// CampaignsService.List method.
+type CampaignListOptions struct {
+	ListOptions
+}
+
+// List campaigns in a given status.
+//
+// MailerLite API docs: https://developers.mailerlite.com/reference/campaigns-by-type
+func (s *CampaignsService) List(ctx context.Context, status CampaignStatus, opts *CampaignListOptions, callOpts ...CallOption) ([]Campaign, *Response, error) {
+	ctx = withOperation(ctx, "CampaignsService.List")
+
+	u := fmt.Sprintf("campaigns/%s", status)
+
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil, callOpts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var campaigns []Campaign
+	resp, err := s.client.Do(req, &campaigns)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return campaigns, resp, nil
+}
@@ -0,0 +1,132 @@
+package mailerlite
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestCampaignsService_List(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/campaigns/sent", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		if got, want := r.URL.RawQuery, "limit=5"; got != want {
+			t.Errorf("query: got %s, want %s", got, want)
+		}
+
+		_, _ = w.Write([]byte(`[{
+			"id": 1,
+			"name": "Newsletter",
+			"type": "regular",
+			"status": "sent",
+			"total_recipients": 100,
+			"opened": {"count": 50, "rate": 50},
+			"clicked": {"count": 10, "rate": 10},
+			"date_created": "2022-01-01 10:00:00",
+			"date_send": "2022-01-02 10:00:00"
+		}]`))
+	})
+
+	campaigns, _, err := client.Campaigns.List(context.Background(), CampaignSent, &CampaignListOptions{
+		ListOptions: ListOptions{Limit: 5},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(campaigns) != 1 {
+		t.Fatalf("expected 1 campaign, got %d", len(campaigns))
+	}
+
+	campaign := campaigns[0]
+
+	if campaign.Status != CampaignSent || campaign.Opened.Count != 50 || campaign.DateSend == nil {
+		t.Errorf("unexpected campaign: %+v", campaign)
+	}
+}
+
+func TestCampaignsService_Report(t *testing.T) {
+	client, mux := setup(t, ConnectToken("token"))
+
+	mux.HandleFunc("/campaigns/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		if got, want := r.Header.Get("Authorization"), "Bearer token"; got != want {
+			t.Errorf("authorization header: got %q, want %q", got, want)
+		}
+
+		if got := r.Header.Get(headerAPIKey); got != "" {
+			t.Errorf("unexpected API key header: %q", got)
+		}
+
+		_, _ = w.Write([]byte(`{"data": {
+			"id": "1",
+			"name": "Newsletter",
+			"stats": {
+				"sent": 100,
+				"unique_opens_count": 50,
+				"open_rate": {"float": 0.5, "string": "50%"}
+			}
+		}}`))
+	})
+
+	report, _, err := client.Campaigns.Report(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.ID != 1 || report.Stats.Sent != 100 || report.Stats.UniqueOpensCount != 50 || report.Stats.OpenRate.Float != 0.5 {
+		t.Errorf("unexpected report: %+v", report)
+	}
+}
+
+func TestCampaignsService_Activity(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/campaigns/1/reports/subscriber-activity", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		if got, want := r.Header.Get("Authorization"), "Bearer api-key"; got != want {
+			t.Errorf("authorization header: got %q, want %q", got, want)
+		}
+
+		if got, want := r.URL.Query().Get("filter[type]"), "clicked"; got != want {
+			t.Errorf("type filter: got %q, want %q", got, want)
+		}
+
+		_, _ = w.Write([]byte(`{
+			"data": [{
+				"id": "10",
+				"opens_count": 2,
+				"clicks_count": 3,
+				"subscriber": {"id": "20", "email": "john@example.com"},
+				"clicks": [
+					{"url": "https://example.com/pricing", "clicks_count": 2},
+					{"url": "https://example.com/docs", "clicks_count": 1}
+				]
+			}],
+			"links": {},
+			"meta": {"current_page": 1}
+		}`))
+	})
+
+	activity, _, err := client.Campaigns.Activity(context.Background(), 1, &CampaignActivityListOptions{Type: CampaignClicked})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(activity) != 1 || activity[0].ClicksCount != 3 || activity[0].Subscriber.Email != "john@example.com" {
+		t.Fatalf("unexpected activity: %+v", activity)
+	}
+
+	wantClicks := []CampaignLinkClick{
+		{URL: "https://example.com/pricing", ClicksCount: 2},
+		{URL: "https://example.com/docs", ClicksCount: 1},
+	}
+	if got := activity[0].Clicks; !reflect.DeepEqual(got, wantClicks) {
+		t.Errorf("link clicks: got %+v, want %+v", got, wantClicks)
+	}
+}
@@ -0,0 +1,151 @@
+package mailerlite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+const (
+	defaultConnectBaseURL = "https://connect.mailerlite.com/api/"
+
+	headerAuthorization = "Authorization"
+)
+
+// ConnectBaseURL tells a Client where to send requests of the MailerLite Connect API (API v3) to.
+// Defaults to the public Connect API, but can be set to a different URL.
+// ConnectBaseURL should always be specified with a trailing slash.
+//
+// Automations, forms and campaign reports are only available in the Connect API.
+func ConnectBaseURL(baseURL *url.URL) ClientOption {
+	return clientOptionFunc(func(c *Client) {
+		if baseURL == nil {
+			return
+		}
+
+		c.connectBaseURL = baseURL
+	})
+}
+
+// ConnectToken configures a Client to authenticate requests sent to the MailerLite Connect API (API v3)
+// with a different token than the API key passed to NewClient.
+//
+// Without a Connect token, the API key is sent as the bearer token of Connect API requests.
+// This only works if the key is also valid for the Connect API, which classic (API v2) keys are not:
+// set a Connect token to use automations, forms or campaign reports.
+func ConnectToken(token string) ClientOption {
+	return clientOptionFunc(func(c *Client) {
+		c.connectToken = token
+	})
+}
+
+// newConnectRequest creates a request to the Connect API (see NewRequest).
+// The request is authenticated with a bearer token instead of the API key header.
+func (c *Client) newConnectRequest(ctx context.Context, method string, urlStr string, body interface{}, opts ...CallOption) (*http.Request, error) {
+	opts = append([]CallOption{WithBaseURL(c.connectBaseURL)}, opts...)
+
+	req, err := c.NewRequest(ctx, method, urlStr, body, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	token := c.connectToken
+	if token == "" {
+		token = c.apiKey
+	}
+
+	req.Header.Del(headerAPIKey)
+	req.Header.Set(headerAuthorization, "Bearer "+token)
+
+	return req, nil
+}
+
+// connectResponse is the envelope of Connect API responses.
+type connectResponse[T any] struct {
+	Data T `json:"data"`
+
+	// Pagination details of list responses.
+	Links json.RawMessage `json:"links"`
+	Meta  json.RawMessage `json:"meta"`
+}
+
+// connectDo sends a Connect API request and decodes the data of the response envelope.
+func connectDo[T any](c *Client, req *http.Request) (T, *Response, error) {
+	var v connectResponse[T]
+
+	resp, err := c.Do(req, &v)
+
+	return v.Data, resp, err
+}
+
+// connectStream is like Stream, but decodes the data array of a Connect API response envelope.
+func connectStream[T any](c *Client, req *http.Request, fn func(item T) error) (*Response, error) {
+	ctx := req.Context()
+
+	return c.do(req, c.BareDo, func(body io.Reader) error {
+		dec := json.NewDecoder(body)
+
+		return decodeEnvelope(dec, func() error {
+			return decodeArray(dec, func() error {
+				var item T
+				if err := c.decodeNext(ctx, dec, &item); err != nil {
+					return err
+				}
+
+				return fn(item)
+			})
+		})
+	})
+}
+
+// decodeEnvelope reads a response envelope from dec, calling data to decode the value of its data key.
+// Other keys are skipped.
+func decodeEnvelope(dec *json.Decoder, data func() error) error {
+	tok, err := dec.Token()
+	if err == io.EOF { // nolint: errorlint
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("expected JSON object, got %v", tok)
+	}
+
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		if key == "data" {
+			err = data()
+		} else {
+			var skip json.RawMessage
+			err = dec.Decode(&skip)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = dec.Token() // closing }
+
+	return err
+}
+
+// PageOptions specifies the optional parameters to Connect API methods that support pagination.
+type PageOptions struct {
+	Page  int `url:"page,omitempty"`
+	Limit int `url:"limit,omitempty"`
+}
+
+// Ratio is a rate returned by the Connect API both as a fraction and as a formatted percentage.
+type Ratio struct {
+	Float  float64 `json:"float"`
+	String string  `json:"string"`
+}
@@ -0,0 +1,451 @@
+package mailerlite
+
+import (
+	"bytes"
+	"context"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// DecodeWarningKind distinguishes the reasons of decode warnings.
+type DecodeWarningKind string
+
+const (
+	// UnknownField is reported for fields of a response that the model has no place for.
+	UnknownField DecodeWarningKind = "unknown_field"
+
+	// TypeMismatch is reported for values of a response that do not match the type of the model field.
+	TypeMismatch DecodeWarningKind = "type_mismatch"
+)
+
+// DecodeWarning describes a difference between a response and the model it is decoded into.
+type DecodeWarning struct {
+	// Operation that received the response (eg. "SubscribersService.Get").
+	Operation string
+
+	Kind DecodeWarningKind
+
+	// Path of the value in the response (eg. "[2].fields[0].value").
+	Path string
+
+	// Go type of the model field (empty for unknown fields).
+	Expected string
+
+	// JSON type of the value in the response (object, array, string, number, bool).
+	Actual string
+}
+
+func (w DecodeWarning) String() string {
+	switch w.Kind {
+	case UnknownField:
+		return fmt.Sprintf("%s: unknown field %q (%s)", w.Operation, w.Path, w.Actual)
+
+	default:
+		return fmt.Sprintf("%s: field %q is %s, expected %s", w.Operation, w.Path, w.Actual, w.Expected)
+	}
+}
+
+// ValueError is returned when a JSON value cannot be decoded into a type implementing its own decoding
+// (eg. Timestamp or WeakInt).
+// Clients configured with StrictDecoding report these values as warnings instead.
+type ValueError struct {
+	// JSON value that could not be decoded.
+	Value string
+
+	// Go type the value was decoded into.
+	Type string
+
+	Err error
+}
+
+func (e *ValueError) Error() string {
+	return fmt.Sprintf("mailerlite: cannot decode %s into %s: %v", e.Value, e.Type, e.Err)
+}
+
+func (e *ValueError) Unwrap() error {
+	return e.Err
+}
+
+// valueError wraps an error returned while decoding data into v.
+func valueError(data []byte, v interface{}, err error) error {
+	var valueErr *ValueError
+	if err == nil || errors.As(err, &valueErr) {
+		return err
+	}
+
+	return &ValueError{Value: string(data), Type: reflect.TypeOf(v).Elem().String(), Err: err}
+}
+
+// StrictDecoding configures a Client to compare responses with the models they are decoded into.
+// Unknown fields and values of unexpected types are reported to fn instead of failing the call:
+// mismatched values are left empty in the model.
+func StrictDecoding(fn func(w DecodeWarning)) ClientOption {
+	return clientOptionFunc(func(c *Client) {
+		c.decodeWarnings = fn
+	})
+}
+
+// RetainRaw configures a Client to keep the raw JSON of every model in its Raw field.
+func RetainRaw() ClientOption {
+	return clientOptionFunc(func(c *Client) {
+		c.retainRaw = true
+	})
+}
+
+// decode decodes a response body into v according to the decoding options of the Client.
+func (c *Client) decode(ctx context.Context, data []byte, v interface{}) error {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil // ignore empty response body
+	}
+
+	body := data
+
+	if c.decodeWarnings != nil {
+		operation := operationFromContext(ctx)
+
+		body = checkSchema(reflect.TypeOf(v), data, "", func(w DecodeWarning) {
+			w.Operation = operation
+			c.decodeWarnings(w)
+		})
+	}
+
+	err := json.Unmarshal(body, v)
+
+	var (
+		typeErr  *json.UnmarshalTypeError
+		valueErr *ValueError
+	)
+	if c.decodeWarnings != nil && (errors.As(err, &typeErr) || errors.As(err, &valueErr)) {
+		err = nil // already reported as a warning
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if c.retainRaw {
+		retainRaw(reflect.ValueOf(v), data)
+	}
+
+	return nil
+}
+
+var (
+	rawMessageType      = reflect.TypeOf(json.RawMessage{})
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	emptyInterfaceType  = reflect.TypeOf((*interface{})(nil)).Elem()
+)
+
+// JSON value kinds reported in decode warnings.
+const (
+	jsonKindNull   = "null"
+	jsonKindObject = "object"
+	jsonKindArray  = "array"
+	jsonKindString = "string"
+	jsonKindNumber = "number"
+	jsonKindBool   = "bool"
+)
+
+// retainRaw stores the raw JSON of models in their Raw field.
+func retainRaw(v reflect.Value, data []byte) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			retainRaw(v.Elem(), data)
+		}
+
+	case reflect.Struct:
+		if decodesItself(v.Type()) {
+			return
+		}
+
+		if raw := v.FieldByName("Raw"); raw.IsValid() && raw.Type() == rawMessageType && raw.CanSet() {
+			raw.SetBytes(append(json.RawMessage(nil), data...))
+		}
+
+		var object map[string]json.RawMessage
+		if json.Unmarshal(data, &object) != nil {
+			return
+		}
+
+		for key, value := range object {
+			field, ok := fieldByJSONName(v.Type(), key)
+			if !ok {
+				continue
+			}
+
+			if fv, err := v.FieldByIndexErr(field.Index); err == nil {
+				retainRaw(fv, value)
+			}
+		}
+
+	case reflect.Slice, reflect.Array:
+		if v.Type() == rawMessageType {
+			return
+		}
+
+		var items []json.RawMessage
+		if json.Unmarshal(data, &items) != nil {
+			return
+		}
+
+		for i := 0; i < len(items) && i < v.Len(); i++ {
+			retainRaw(v.Index(i), items[i])
+		}
+	}
+}
+
+// checkSchema reports the differences between data and a Go type.
+// Values that a type implementing its own decoding rejects are reported at the field holding them
+// and replaced with null in the returned data, so they are left empty in the model.
+func checkSchema(t reflect.Type, data []byte, path string, report func(w DecodeWarning)) []byte {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	actual := jsonKind(data)
+	if actual == jsonKindNull || t == rawMessageType || t == emptyInterfaceType {
+		return data
+	}
+
+	mismatch := func() {
+		report(DecodeWarning{Kind: TypeMismatch, Path: path, Expected: t.String(), Actual: actual})
+	}
+
+	if decodesItself(t) {
+		if json.Unmarshal(data, reflect.New(t).Interface()) != nil {
+			mismatch()
+
+			return []byte("null")
+		}
+
+		return data
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		var object map[string]json.RawMessage
+		if json.Unmarshal(data, &object) != nil {
+			mismatch()
+
+			return data
+		}
+
+		changed := false
+
+		for key, value := range object {
+			field, ok := fieldByJSONName(t, key)
+			if !ok {
+				report(DecodeWarning{Kind: UnknownField, Path: joinPath(path, key), Actual: jsonKind(value)})
+
+				continue
+			}
+
+			if checked := checkSchema(field.Type, value, joinPath(path, key), report); !bytes.Equal(checked, value) {
+				object[key] = checked
+				changed = true
+			}
+		}
+
+		if changed {
+			return mustMarshal(object, data)
+		}
+
+	case reflect.Map:
+		var object map[string]json.RawMessage
+		if json.Unmarshal(data, &object) != nil {
+			mismatch()
+
+			return data
+		}
+
+		changed := false
+
+		for key, value := range object {
+			if checked := checkSchema(t.Elem(), value, joinPath(path, key), report); !bytes.Equal(checked, value) {
+				object[key] = checked
+				changed = true
+			}
+		}
+
+		if changed {
+			return mustMarshal(object, data)
+		}
+
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 && actual == jsonKindString {
+			return data
+		}
+
+		var items []json.RawMessage
+		if json.Unmarshal(data, &items) != nil {
+			mismatch()
+
+			return data
+		}
+
+		changed := false
+
+		for i, item := range items {
+			if checked := checkSchema(t.Elem(), item, fmt.Sprintf("%s[%d]", path, i), report); !bytes.Equal(checked, item) {
+				items[i] = checked
+				changed = true
+			}
+		}
+
+		if changed {
+			return mustMarshal(items, data)
+		}
+
+	case reflect.String:
+		if actual != jsonKindString {
+			mismatch()
+		}
+
+	case reflect.Bool:
+		if actual != jsonKindBool {
+			mismatch()
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if actual != jsonKindNumber || bytes.ContainsAny(data, ".eE") {
+			mismatch()
+		}
+
+	case reflect.Float32, reflect.Float64:
+		if actual != jsonKindNumber {
+			mismatch()
+		}
+	}
+
+	return data
+}
+
+// mustMarshal encodes v, falling back to data if v cannot be encoded.
+func mustMarshal(v interface{}, data []byte) []byte {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return data
+	}
+
+	return encoded
+}
+
+// decodesItself reports whether a type implements its own JSON decoding.
+func decodesItself(t reflect.Type) bool {
+	p := reflect.PtrTo(t)
+
+	return p.Implements(jsonUnmarshalerType) || p.Implements(textUnmarshalerType)
+}
+
+// fieldByJSONName finds the struct field a JSON object key is decoded into
+// (using the same case-insensitive matching as encoding/json).
+func fieldByJSONName(t reflect.Type, key string) (reflect.StructField, bool) {
+	var fallback *reflect.StructField
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		name, skip := jsonFieldName(field)
+		if skip {
+			continue
+		}
+
+		if name == "" { // embedded struct without a name
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+
+			if inner, ok := fieldByJSONName(embedded, key); ok {
+				inner.Index = append([]int{i}, inner.Index...)
+
+				return inner, true
+			}
+
+			continue
+		}
+
+		if name == key {
+			return field, true
+		}
+
+		if fallback == nil && strings.EqualFold(name, key) {
+			fallback = &field
+		}
+	}
+
+	if fallback != nil {
+		return *fallback, true
+	}
+
+	return reflect.StructField{}, false
+}
+
+// jsonFieldName returns the name of a struct field in JSON,
+// or an empty name for embedded structs whose fields are promoted.
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", true
+	}
+
+	name := strings.Split(tag, ",")[0]
+
+	if field.Anonymous && name == "" {
+		t := field.Type
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+
+		if t.Kind() == reflect.Struct {
+			return "", false
+		}
+	}
+
+	if !field.IsExported() {
+		return "", true
+	}
+
+	if name == "" {
+		name = field.Name
+	}
+
+	return name, false
+}
+
+func jsonKind(data []byte) string {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return jsonKindNull
+	}
+
+	switch data[0] {
+	case 'n':
+		return jsonKindNull
+	case '{':
+		return jsonKindObject
+	case '[':
+		return jsonKindArray
+	case '"':
+		return jsonKindString
+	case 't', 'f':
+		return jsonKindBool
+	default:
+		return jsonKindNumber
+	}
+}
+
+func joinPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
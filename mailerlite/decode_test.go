@@ -0,0 +1,116 @@
+package mailerlite
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+const invalidSubscriber = `{"id": 1, "email": "john@example.com", "date_created": "yesterday"}`
+
+func TestClient_Decode_ValueError(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/subscribers/john@example.com", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(invalidSubscriber))
+	})
+
+	_, _, err := client.Subscribers.Get(context.Background(), "john@example.com")
+
+	var valueErr *ValueError
+	if !errors.As(err, &valueErr) {
+		t.Fatalf("expected a *ValueError, got %v", err)
+	}
+}
+
+func TestClient_Decode_StrictDecoding(t *testing.T) {
+	var warnings []DecodeWarning
+
+	client, mux := setup(t, StrictDecoding(func(w DecodeWarning) {
+		warnings = append(warnings, w)
+	}))
+
+	mux.HandleFunc("/subscribers/john@example.com", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(invalidSubscriber))
+	})
+
+	subscriber, _, err := client.Subscribers.Get(context.Background(), "john@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if subscriber.Email != "john@example.com" || subscriber.ID != 1 || !subscriber.DateCreated.IsZero() {
+		t.Errorf("unexpected subscriber: %+v", subscriber)
+	}
+
+	paths := map[string]bool{}
+	for _, w := range warnings {
+		if w.Kind == TypeMismatch {
+			paths[w.Path] = true
+		}
+	}
+
+	if !paths["date_created"] || len(warnings) != 1 {
+		t.Errorf("unexpected warnings: %+v", warnings)
+	}
+}
+
+func TestClient_Decode_RetainRaw(t *testing.T) {
+	const (
+		john = `{"id": 1, "email": "john@example.com", "unknown": true}`
+		jane = `{"id": 2, "email": "jane@example.com"}`
+	)
+
+	tests := map[string]struct {
+		opts []ClientOption
+		want []string
+	}{
+		"on":  {opts: []ClientOption{RetainRaw()}, want: []string{john, jane}},
+		"off": {want: []string{"", ""}},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			client, mux := setup(t, test.opts...)
+
+			mux.HandleFunc("/subscribers", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`[` + john + `, ` + jane + `]`))
+			})
+
+			mux.HandleFunc("/subscribers/john@example.com", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(john))
+			})
+
+			subscribers, _, err := client.Subscribers.List(context.Background(), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(subscribers) != 2 {
+				t.Fatalf("expected 2 subscribers, got %d", len(subscribers))
+			}
+
+			for i, subscriber := range subscribers {
+				if got := string(subscriber.Raw); got != test.want[i] {
+					t.Errorf("raw JSON of subscriber %d: got %q, want %q", i, got, test.want[i])
+				}
+			}
+
+			subscriber, _, err := client.Subscribers.Get(context.Background(), "john@example.com")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := string(subscriber.Raw); got != test.want[0] {
+				t.Errorf("raw JSON: got %q, want %q", got, test.want[0])
+			}
+
+			if test.want[0] == "" && subscriber.Raw != nil {
+				t.Errorf("raw JSON should not be kept, got %q", subscriber.Raw)
+			}
+		})
+	}
+}
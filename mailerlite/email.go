@@ -0,0 +1,202 @@
+package mailerlite
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+)
+
+// pathEscape escapes a value (eg. an email address) so it can be safely placed in a URL path segment.
+// Plus signs are escaped as well, because some servers decode them as spaces.
+func pathEscape(s string) string {
+	return strings.ReplaceAll(url.PathEscape(s), "+", "%2B")
+}
+
+// EmailValidationError is returned when an email address is invalid.
+type EmailValidationError struct {
+	Email  string
+	Reason string
+
+	redact RedactionPolicy
+}
+
+func (e *EmailValidationError) Error() string {
+	return redactString(e.redact, e.Unredacted())
+}
+
+// Unredacted returns the error message without applying the redaction policy of the Client.
+func (e *EmailValidationError) Unredacted() string {
+	return fmt.Sprintf("invalid email address %q: %s", e.Email, e.Reason)
+}
+
+// EmailValidator validates and normalizes email addresses before they are sent to the API.
+type EmailValidator struct {
+	// IsDisposable reports whether a (normalized) domain belongs to a disposable email provider.
+	// Addresses of disposable domains are rejected. Optional.
+	IsDisposable func(domain string) bool
+}
+
+// ValidateEmails configures a Client to validate and normalize email addresses
+// passed to SubscribersService.Create, SubscribersService.Update and GroupsService.AddSubscriber.
+// Invalid addresses are rejected with an *EmailValidationError without sending any request.
+func ValidateEmails(validator *EmailValidator) ClientOption {
+	return clientOptionFunc(func(c *Client) {
+		c.emailValidator = validator
+	})
+}
+
+// validateEmail normalizes an email address if the Client is configured to validate email addresses.
+func (c *Client) validateEmail(email string) (string, error) {
+	if c.emailValidator == nil {
+		return email, nil
+	}
+
+	email, err := c.emailValidator.Normalize(email)
+
+	return email, c.redactError(err)
+}
+
+// NormalizeEmail validates an email address and returns its normalized form.
+// See EmailValidator.Normalize for details.
+func NormalizeEmail(email string) (string, error) {
+	return (&EmailValidator{}).Normalize(email)
+}
+
+// Normalize validates an email address and returns its normalized form:
+// surrounding whitespace is removed, the domain is lowercased
+// and internationalized domain names are converted to their ASCII (punycode) form.
+// The local part is kept as is.
+func (v *EmailValidator) Normalize(email string) (string, error) {
+	invalid := func(reason string) (string, error) {
+		return "", &EmailValidationError{Email: email, Reason: reason}
+	}
+
+	address := strings.TrimSpace(email)
+
+	if !utf8.ValidString(address) {
+		return invalid("not valid UTF-8")
+	}
+
+	at := strings.LastIndex(address, "@")
+	if at < 0 {
+		return invalid("missing @")
+	}
+
+	local, domain := address[:at], address[at+1:]
+
+	if reason := checkLocalPart(local); reason != "" {
+		return invalid(reason)
+	}
+
+	domain, reason := normalizeDomain(domain)
+	if reason != "" {
+		return invalid(reason)
+	}
+
+	address = local + "@" + domain
+
+	if len(address) > 254 {
+		return invalid("address is longer than 254 characters")
+	}
+
+	if v.IsDisposable != nil && v.IsDisposable(domain) {
+		return invalid("disposable email domain")
+	}
+
+	return address, nil
+}
+
+// checkLocalPart validates the part of an address before the @ sign (as an unquoted dot-atom).
+func checkLocalPart(local string) string {
+	switch {
+	case local == "":
+		return "empty local part"
+
+	case len(local) > 64:
+		return "local part is longer than 64 characters"
+
+	case strings.HasPrefix(local, ".") || strings.HasSuffix(local, "."):
+		return "local part starts or ends with a dot"
+
+	case strings.Contains(local, ".."):
+		return "local part contains consecutive dots"
+	}
+
+	for _, r := range local {
+		if r == '.' || isAtext(r) {
+			continue
+		}
+
+		return fmt.Sprintf("invalid character %q in local part", r)
+	}
+
+	return ""
+}
+
+// isAtext reports whether r may appear in an unquoted local part (RFC 5322 and RFC 6531).
+func isAtext(r rune) bool {
+	if r > unicode.MaxASCII {
+		return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r)
+	}
+
+	if 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || '0' <= r && r <= '9' {
+		return true
+	}
+
+	return strings.ContainsRune("!#$%&'*+/=?^_`{|}~-", r)
+}
+
+// normalizeDomain converts a domain to its lowercase ASCII form (internationalized labels are punycode encoded).
+func normalizeDomain(domain string) (string, string) {
+	domain = strings.TrimSuffix(domain, ".")
+
+	if domain == "" {
+		return "", "empty domain"
+	}
+
+	domain, err := idna.Lookup.ToASCII(domain)
+	if err != nil {
+		return "", fmt.Sprintf("invalid domain: %v", err)
+	}
+
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return "", "domain must have at least two labels"
+	}
+
+	for _, label := range labels {
+		if label == "" {
+			return "", "domain contains an empty label"
+		}
+
+		if len(label) > 63 {
+			return "", fmt.Sprintf("domain label %q is longer than 63 characters", label)
+		}
+
+		if strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return "", fmt.Sprintf("domain label %q starts or ends with a hyphen", label)
+		}
+
+		for _, r := range label {
+			if 'a' <= r && r <= 'z' || '0' <= r && r <= '9' || r == '-' {
+				continue
+			}
+
+			return "", fmt.Sprintf("invalid character %q in domain", r)
+		}
+	}
+
+	if strings.Trim(labels[len(labels)-1], "0123456789") == "" {
+		return "", "top level domain is numeric"
+	}
+
+	if len(domain) > 253 {
+		return "", "domain is longer than 253 characters"
+	}
+
+	return domain, ""
+}
@@ -0,0 +1,75 @@
+package mailerlite
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalizeEmail(t *testing.T) {
+	tests := []struct {
+		email string
+		want  string
+	}{
+		{"john@example.com", "john@example.com"},
+		{"  John.Doe+tag@Example.COM. ", "John.Doe+tag@example.com"},
+		{"user@bücher.de", "user@xn--bcher-kva.de"},
+		{"user@BÜCHER.de", "user@xn--bcher-kva.de"},
+		{"user@xn--bcher-kva.de", "user@xn--bcher-kva.de"},
+		{"user@例え.テスト", "user@xn--r8jz45g.xn--zckzah"},
+		{"josé@example.com", "josé@example.com"},
+
+		// RFC 3492 section 7.1 sample strings
+		{"user@ليهمابتكلموشعربي؟.example", "user@xn--egbpdaj6bu4bxfgehfvwxn.example"},
+		{"user@他们为什么不说中文.example", "user@xn--ihqwcrb4cv8a8dqg056pqjye.example"},
+		{"user@pročprostěnemluvíčesky.example", "user@xn--proprostnemluvesky-uyb24dma41a.example"},
+		{"user@почемужеонинеговорятпорусски.example", "user@xn--b1abfaaepdrnnbgefbadotcwatmq2g4l.example"},
+		{"user@porquénopuedensimplementehablarenespañol.example", "user@xn--porqunopuedensimplementehablarenespaol-fmd56a.example"},
+		{"user@3年b組金八先生.example", "user@xn--3b-ww4c5e180e575a65lsy2b.example"},
+		{"user@パフィーdeルンバ.example", "user@xn--de-jg4avhby1noc0d.example"},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.email, func(t *testing.T) {
+			got, err := NormalizeEmail(test.email)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeEmail_Invalid(t *testing.T) {
+	tests := []string{
+		"",
+		"john",
+		"@example.com",
+		"john@",
+		"john@localhost",
+		"john..doe@example.com",
+		"john@example..com",
+		"john@-example.com",
+		"john@example.123",
+		"john doe@example.com",
+		"john@exa mple.com",
+		"john@exam_ple.com",
+	}
+
+	for _, email := range tests {
+		email := email
+
+		t.Run(email, func(t *testing.T) {
+			_, err := NormalizeEmail(email)
+
+			var validationErr *EmailValidationError
+			if !errors.As(err, &validationErr) {
+				t.Errorf("expected an *EmailValidationError, got %v", err)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package mailerlite
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 )
@@ -14,12 +15,14 @@ type FieldsService service
 
 // Field represents a custom field in a subscriber profile.
 type Field struct {
-	ID          WeakInt   `json:"id"`
-	Title       string    `json:"title"`
-	Key         string    `json:"key"`
-	Type        FieldType `json:"type"`
-	DateUpdated Timestamp `json:"date_updated"`
-	DateCreated Timestamp `json:"date_created"`
+	ID          WeakInt    `json:"id"`
+	Title       WeakString `json:"title"`
+	Key         WeakString `json:"key"`
+	Type        FieldType  `json:"type"`
+	DateUpdated Timestamp  `json:"date_updated"`
+	DateCreated Timestamp  `json:"date_created"`
+
+	Raw json.RawMessage `json:"-"` // Raw JSON of the response, see RetainRaw.
 }
 
 // FieldType represents the type of data a field can store.
@@ -34,10 +37,12 @@ const (
 // List all fields.
 //
 // MailerLite API docs: https://developers.mailerlite.com/reference/all-fields
-func (s *FieldsService) List(ctx context.Context) ([]Field, *Response, error) {
+func (s *FieldsService) List(ctx context.Context, callOpts ...CallOption) ([]Field, *Response, error) {
+	ctx = withOperation(ctx, "FieldsService.List")
+
 	u := "fields"
 
-	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil, callOpts...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -60,10 +65,12 @@ type NewField struct {
 // Create a new field.
 //
 // MailerLite API docs: https://developers.mailerlite.com/reference/create-field
-func (s *FieldsService) Create(ctx context.Context, newField NewField) (*Field, *Response, error) {
+func (s *FieldsService) Create(ctx context.Context, newField NewField, callOpts ...CallOption) (*Field, *Response, error) {
+	ctx = withOperation(ctx, "FieldsService.Create")
+
 	u := "fields"
 
-	req, err := s.client.NewRequest(ctx, http.MethodPost, u, newField)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, u, newField, callOpts...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -85,10 +92,12 @@ type FieldUpdate struct {
 // Update a field.
 //
 // MailerLite API docs: https://developers.mailerlite.com/reference/update-field
-func (s *FieldsService) Update(ctx context.Context, id int, update FieldUpdate) (*Field, *Response, error) {
+func (s *FieldsService) Update(ctx context.Context, id int, update FieldUpdate, callOpts ...CallOption) (*Field, *Response, error) {
+	ctx = withOperation(ctx, "FieldsService.Update")
+
 	u := fmt.Sprintf("fields/%d", id)
 
-	req, err := s.client.NewRequest(ctx, http.MethodPut, u, update)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, u, update, callOpts...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -105,10 +114,12 @@ func (s *FieldsService) Update(ctx context.Context, id int, update FieldUpdate)
 // Delete a field.
 //
 // MailerLite API docs: https://developers.mailerlite.com/reference/all-fields
-func (s *FieldsService) Delete(ctx context.Context, id int) (*Response, error) {
+func (s *FieldsService) Delete(ctx context.Context, id int, callOpts ...CallOption) (*Response, error) {
+	ctx = withOperation(ctx, "FieldsService.Delete")
+
 	u := fmt.Sprintf("fields/%d", id)
 
-	req, err := s.client.NewRequest(ctx, http.MethodDelete, u, nil)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, u, nil, callOpts...)
 	if err != nil {
 		return nil, err
 	}
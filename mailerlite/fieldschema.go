@@ -0,0 +1,316 @@
+package mailerlite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// builtinFields are the keys of the default fields of every account.
+// They cannot be deleted.
+var builtinFields = map[string]bool{
+	"email":     true,
+	"name":      true,
+	"last_name": true,
+	"company":   true,
+	"country":   true,
+	"city":      true,
+	"phone":     true,
+	"state":     true,
+	"zip":       true,
+}
+
+// FieldDefinition describes the expected state of a custom field.
+//
+// The key of a field is derived from its title by MailerLite when the field is created.
+// If Key is set, it must match the derived key, otherwise applying the plan fails.
+type FieldDefinition struct {
+	Title string    `json:"title"`
+	Key   string    `json:"key,omitempty"` // Matched against the title if empty
+	Type  FieldType `json:"type"`
+}
+
+// ParseFieldDefinitions decodes a JSON array of field definitions.
+// Definitions kept in other formats (eg. YAML) can be decoded into []FieldDefinition by the caller.
+func ParseFieldDefinitions(r io.Reader) ([]FieldDefinition, error) {
+	var definitions []FieldDefinition
+
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+
+	err := dec.Decode(&definitions)
+	if err != nil {
+		return nil, err
+	}
+
+	return definitions, nil
+}
+
+// FieldActionType represents a change required to reconcile a field.
+type FieldActionType string
+
+const (
+	FieldActionCreate       FieldActionType = "create"
+	FieldActionRename       FieldActionType = "rename"
+	FieldActionDelete       FieldActionType = "delete"
+	FieldActionTypeConflict FieldActionType = "type_conflict"
+)
+
+// FieldAction is a single change in a FieldPlan.
+type FieldAction struct {
+	Type FieldActionType
+
+	// Definition is the expected state of the field (nil for deletes).
+	Definition *FieldDefinition
+
+	// Field is the current state of the field (nil for creates).
+	Field *Field
+}
+
+func (a FieldAction) String() string {
+	switch a.Type {
+	case FieldActionCreate:
+		return fmt.Sprintf("create %q (%s)", a.Definition.Title, a.Definition.Type)
+
+	case FieldActionRename:
+		return fmt.Sprintf("rename %s: %q -> %q", a.Field.Key, a.Field.Title, a.Definition.Title)
+
+	case FieldActionDelete:
+		return fmt.Sprintf("delete %s (%q)", a.Field.Key, a.Field.Title)
+
+	case FieldActionTypeConflict:
+		return fmt.Sprintf("type conflict %s: %s (expected %s)", a.Field.Key, a.Field.Type, a.Definition.Type)
+	}
+
+	return string(a.Type)
+}
+
+// FieldPlan is the list of changes required to reconcile the fields of an account with a set of definitions.
+type FieldPlan struct {
+	Actions []FieldAction
+}
+
+// Empty reports whether the fields of the account already match the definitions.
+func (p *FieldPlan) Empty() bool {
+	return len(p.Actions) == 0
+}
+
+// Conflicts returns the actions that cannot be applied automatically.
+func (p *FieldPlan) Conflicts() []FieldAction {
+	var conflicts []FieldAction
+
+	for _, action := range p.Actions {
+		if action.Type == FieldActionTypeConflict {
+			conflicts = append(conflicts, action)
+		}
+	}
+
+	return conflicts
+}
+
+func (p *FieldPlan) String() string {
+	var b strings.Builder
+
+	for _, action := range p.Actions {
+		b.WriteString(action.String())
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// FieldConflictError is returned when applying a plan that contains type conflicts.
+// The type of a field cannot be changed through the API, so conflicts have to be resolved manually.
+type FieldConflictError struct {
+	Conflicts []FieldAction
+}
+
+func (e *FieldConflictError) Error() string {
+	keys := make([]string, 0, len(e.Conflicts))
+	for _, conflict := range e.Conflicts {
+		keys = append(keys, string(conflict.Field.Key))
+	}
+
+	return fmt.Sprintf("field type conflicts must be resolved manually: %s", strings.Join(keys, ", "))
+}
+
+// FieldPlanOptions specifies the optional parameters to the FieldsService.Plan method.
+type FieldPlanOptions struct {
+	// Delete fields that are not defined. Built-in fields are never deleted.
+	Prune bool
+}
+
+// Plan compares the fields of the account with a set of definitions
+// and returns the changes required to reconcile them, without applying anything.
+// Definitions sharing a key or a title are rejected.
+func (s *FieldsService) Plan(ctx context.Context, definitions []FieldDefinition, opts *FieldPlanOptions, callOpts ...CallOption) (*FieldPlan, *Response, error) {
+	ctx = withOperation(ctx, "FieldsService.Plan")
+
+	if opts == nil {
+		opts = &FieldPlanOptions{}
+	}
+
+	keys := make(map[string]bool, len(definitions))
+	titles := make(map[string]bool, len(definitions))
+
+	for _, definition := range definitions {
+		if definition.Title == "" {
+			return nil, nil, fmt.Errorf("field definition must have a title (key: %q)", definition.Key)
+		}
+
+		switch definition.Type {
+		case Text, Number, Date:
+		default:
+			return nil, nil, fmt.Errorf("field definition %q has invalid type %q", definition.Title, definition.Type)
+		}
+
+		if definition.Key != "" {
+			if keys[definition.Key] {
+				return nil, nil, fmt.Errorf("duplicate field definition with key %q", definition.Key)
+			}
+
+			keys[definition.Key] = true
+		}
+
+		title := strings.ToLower(definition.Title)
+		if titles[title] {
+			return nil, nil, fmt.Errorf("duplicate field definition with title %q", definition.Title)
+		}
+
+		titles[title] = true
+	}
+
+	fields, resp, err := s.List(ctx, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	plan := &FieldPlan{}
+	matchedBy := make(map[string]string, len(fields)) // field key -> definition title
+
+	for i := range definitions {
+		definition := &definitions[i]
+
+		field := matchField(fields, definition)
+		if field == nil {
+			plan.Actions = append(plan.Actions, FieldAction{
+				Type:       FieldActionCreate,
+				Definition: definition,
+			})
+
+			continue
+		}
+
+		key := string(field.Key)
+
+		if title, ok := matchedBy[key]; ok {
+			return nil, resp, fmt.Errorf("field definitions %q and %q match the same field %s", title, definition.Title, field.Key)
+		}
+
+		matchedBy[key] = definition.Title
+
+		if field.Type != definition.Type {
+			plan.Actions = append(plan.Actions, FieldAction{
+				Type:       FieldActionTypeConflict,
+				Definition: definition,
+				Field:      field,
+			})
+
+			continue
+		}
+
+		if string(field.Title) != definition.Title {
+			plan.Actions = append(plan.Actions, FieldAction{
+				Type:       FieldActionRename,
+				Definition: definition,
+				Field:      field,
+			})
+		}
+	}
+
+	if opts.Prune {
+		for i := range fields {
+			field := &fields[i]
+
+			if _, ok := matchedBy[string(field.Key)]; ok || builtinFields[string(field.Key)] {
+				continue
+			}
+
+			plan.Actions = append(plan.Actions, FieldAction{
+				Type:  FieldActionDelete,
+				Field: field,
+			})
+		}
+	}
+
+	return plan, resp, nil
+}
+
+// matchField finds the field matching a definition by key, or by title if the definition has no key.
+func matchField(fields []Field, definition *FieldDefinition) *Field {
+	for i := range fields {
+		if definition.Key != "" {
+			if string(fields[i].Key) == definition.Key {
+				return &fields[i]
+			}
+
+			continue
+		}
+
+		if strings.EqualFold(string(fields[i].Title), definition.Title) {
+			return &fields[i]
+		}
+	}
+
+	return nil
+}
+
+// Apply executes the changes of a plan.
+// Plans containing type conflicts are rejected with a *FieldConflictError before any change is made.
+//
+// Creating a field whose definition has a key different from the one derived by MailerLite fails
+// (after the field has been created), since the plan would never converge otherwise.
+func (s *FieldsService) Apply(ctx context.Context, plan *FieldPlan, callOpts ...CallOption) (*Response, error) {
+	ctx = withOperation(ctx, "FieldsService.Apply")
+
+	callOpts = sequenceCallOptions(callOpts)
+
+	if conflicts := plan.Conflicts(); len(conflicts) > 0 {
+		return nil, &FieldConflictError{Conflicts: conflicts}
+	}
+
+	var resp *Response
+
+	for _, action := range plan.Actions {
+		var err error
+
+		switch action.Type {
+		case FieldActionCreate:
+			var field *Field
+
+			field, resp, err = s.Create(ctx, NewField{
+				Title: action.Definition.Title,
+				Type:  action.Definition.Type,
+			}, callOpts...)
+			if err == nil && action.Definition.Key != "" && string(field.Key) != action.Definition.Key {
+				err = fmt.Errorf("field was created with key %q instead of %q: change the key or the title of the definition", field.Key, action.Definition.Key)
+			}
+
+		case FieldActionRename:
+			_, resp, err = s.Update(ctx, int(action.Field.ID), FieldUpdate{
+				Title: action.Definition.Title,
+			}, callOpts...)
+
+		case FieldActionDelete:
+			resp, err = s.Delete(ctx, int(action.Field.ID), callOpts...)
+		}
+
+		if err != nil {
+			return resp, fmt.Errorf("%s: %w", action, err)
+		}
+	}
+
+	return resp, nil
+}
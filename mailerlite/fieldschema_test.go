@@ -0,0 +1,95 @@
+package mailerlite
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestFieldsService_Plan(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/fields", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[
+			{"id":1,"title":"Email","key":"email","type":"TEXT"},
+			{"id":2,"title":"Plan","key":"plan","type":"TEXT"},
+			{"id":3,"title":"Seats","key":"seats","type":"TEXT"},
+			{"id":4,"title":"Legacy","key":"legacy","type":"TEXT"}
+		]`))
+	})
+
+	plan, _, err := client.Fields.Plan(context.Background(), []FieldDefinition{
+		{Title: "Subscription plan", Key: "plan", Type: Text},
+		{Title: "Seats", Type: Number},
+		{Title: "Trial ends", Type: Date},
+	}, &FieldPlanOptions{Prune: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `rename plan: "Plan" -> "Subscription plan"
+type conflict seats: TEXT (expected NUMBER)
+create "Trial ends" (DATE)
+delete legacy ("Legacy")
+`
+	if got := plan.String(); got != want {
+		t.Errorf("unexpected plan:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestFieldsService_Plan_Duplicates(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/fields", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"id":2,"title":"Plan","key":"plan","type":"TEXT"}]`))
+	})
+
+	tests := map[string][]FieldDefinition{
+		"key": {
+			{Title: "Plan", Key: "plan", Type: Text},
+			{Title: "Subscription plan", Key: "plan", Type: Text},
+		},
+		"title": {
+			{Title: "Plan", Type: Text},
+			{Title: "plan", Key: "subscription_plan", Type: Text},
+		},
+		"field": {
+			{Title: "Plan", Type: Text},
+			{Title: "Subscription plan", Key: "plan", Type: Text},
+		},
+	}
+
+	for name, definitions := range tests {
+		definitions := definitions
+
+		t.Run(name, func(t *testing.T) {
+			_, _, err := client.Fields.Plan(context.Background(), definitions, nil)
+			if err == nil {
+				t.Fatal("expected duplicate definitions to be rejected")
+			}
+		})
+	}
+}
+
+func TestFieldsService_Apply_KeyMismatch(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/fields", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":5,"title":"Trial ends","key":"trial_ends","type":"DATE"}`))
+	})
+
+	plan := &FieldPlan{
+		Actions: []FieldAction{
+			{
+				Type:       FieldActionCreate,
+				Definition: &FieldDefinition{Title: "Trial ends", Key: "trial_end", Type: Date},
+			},
+		},
+	}
+
+	_, err := client.Fields.Apply(context.Background(), plan)
+	if err == nil || !strings.Contains(err.Error(), `"trial_ends"`) {
+		t.Fatalf("expected a key mismatch error, got %v", err)
+	}
+}
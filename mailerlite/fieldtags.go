@@ -0,0 +1,409 @@
+package mailerlite
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// fieldTag is the name of the struct tag mapping struct fields to custom fields.
+const fieldTag = "mailerlite"
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// EncodeFields encodes the tagged fields of a struct into custom field values
+// (eg. for NewSubscriberInGroup.Fields).
+//
+// Struct fields are mapped to custom fields with a tag:
+//
+//	type Customer struct {
+//		Company  string     `mailerlite:"company"`
+//		Seats    int        `mailerlite:"seats,omitempty"`
+//		Renewal  *time.Time `mailerlite:"renewal_date,omitempty"`
+//		Plan     string     `mailerlite:"plan_start,type=date"`
+//		Internal string     `mailerlite:"-"`
+//	}
+//
+// The first tag value is the key of the custom field. Untagged fields are ignored,
+// except for embedded structs (or pointers to structs), whose fields are mapped as if they were declared in the outer struct.
+// Fields of nil embedded pointers are not encoded; DecodeFields allocates them.
+//
+// The field type is inferred from the Go type: strings and booleans are TEXT,
+// numbers are NUMBER and time.Time values are DATE.
+// The "type" option (text, number or date) overrides the inferred type.
+//
+// The "omitempty" option skips zero values. Without it, zero values are encoded as they are,
+// except for nil pointers which are encoded as empty values (clearing the custom field)
+// and zero time.Time values which are always skipped (they are not meaningful dates).
+func EncodeFields(v interface{}) (map[string]FieldValue, error) {
+	rv, err := structValue(v)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]FieldValue)
+
+	err = walkTaggedFields(rv, false, func(opts fieldTagOptions, field reflect.Value) error {
+		if skipField(opts, field) {
+			return nil
+		}
+
+		value, err := encodeFieldValue(field, opts.typ)
+		if err != nil {
+			return fmt.Errorf("encoding field %q: %w", opts.key, err)
+		}
+
+		values[opts.key] = value
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// EncodeSubscriberFields encodes the tagged fields of a struct into a list of custom fields
+// (eg. for SubscriberUpdate.Fields). See EncodeFields for the tag format.
+func EncodeSubscriberFields(v interface{}) ([]SubscriberField, error) {
+	rv, err := structValue(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []SubscriberField
+
+	err = walkTaggedFields(rv, false, func(opts fieldTagOptions, field reflect.Value) error {
+		if skipField(opts, field) {
+			return nil
+		}
+
+		value, err := encodeFieldValue(field, opts.typ)
+		if err != nil {
+			return fmt.Errorf("encoding field %q: %w", opts.key, err)
+		}
+
+		fields = append(fields, SubscriberField{
+			Key:   opts.key,
+			Value: value,
+			Type:  value.Type(),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// DecodeFields decodes custom field values (eg. Subscriber.Fields) into the tagged fields of a struct.
+// v must be a non-nil pointer to a struct. See EncodeFields for the tag format.
+//
+// Struct fields without a corresponding custom field are left untouched,
+// empty values set the struct field to its zero value.
+func DecodeFields(fields []SubscriberField, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("decoding fields requires a non-nil pointer to a struct, got %T", v)
+	}
+
+	values := make(map[string]FieldValue, len(fields))
+	for _, field := range fields {
+		values[field.Key] = field.Value
+	}
+
+	return walkTaggedFields(rv.Elem(), true, func(opts fieldTagOptions, field reflect.Value) error {
+		value, ok := values[opts.key]
+		if !ok {
+			return nil
+		}
+
+		if !field.CanSet() {
+			return fmt.Errorf("decoding field %q: field of an unexported embedded struct cannot be set", opts.key)
+		}
+
+		err := decodeFieldValue(value, field)
+		if err != nil {
+			return fmt.Errorf("decoding field %q: %w", opts.key, err)
+		}
+
+		return nil
+	})
+}
+
+func structValue(v interface{}) (reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("encoding fields requires a struct, got %T", v)
+	}
+
+	return rv, nil
+}
+
+type fieldTagOptions struct {
+	key       string
+	typ       FieldType
+	omitEmpty bool
+}
+
+func parseFieldTag(tag string) (fieldTagOptions, error) {
+	parts := strings.Split(tag, ",")
+
+	opts := fieldTagOptions{key: parts[0]}
+
+	for _, part := range parts[1:] {
+		switch {
+		case part == "omitempty":
+			opts.omitEmpty = true
+
+		case strings.HasPrefix(part, "type="):
+			typ := FieldType(strings.ToUpper(strings.TrimPrefix(part, "type=")))
+
+			switch typ {
+			case Text, Number, Date:
+				opts.typ = typ
+			default:
+				return opts, fmt.Errorf("invalid field type %q in tag %q", typ, tag)
+			}
+
+		default:
+			return opts, fmt.Errorf("unknown option %q in tag %q", part, tag)
+		}
+	}
+
+	return opts, nil
+}
+
+// skipField reports whether a field should be left out when encoding.
+func skipField(opts fieldTagOptions, field reflect.Value) bool {
+	if opts.omitEmpty && field.IsZero() {
+		return true
+	}
+
+	if field.Kind() == reflect.Ptr && !field.IsNil() {
+		field = field.Elem()
+	}
+
+	return field.Type() == timeType && field.IsZero()
+}
+
+// walkTaggedFields calls fn for every tagged field of a struct (including fields of embedded structs).
+// Nil embedded pointers are skipped, or allocated if allocate is true (when decoding).
+func walkTaggedFields(rv reflect.Value, allocate bool, fn func(opts fieldTagOptions, field reflect.Value) error) error {
+	rt := rv.Type()
+
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+
+		tag, ok := sf.Tag.Lookup(fieldTag)
+
+		if !ok {
+			if !sf.Anonymous {
+				continue
+			}
+
+			embedded := rv.Field(i)
+
+			if embedded.Kind() == reflect.Ptr && embedded.Type().Elem().Kind() == reflect.Struct {
+				if embedded.IsNil() {
+					if !allocate {
+						continue
+					}
+
+					if !embedded.CanSet() {
+						return fmt.Errorf("cannot allocate embedded field %s: it is unexported", sf.Name)
+					}
+
+					embedded.Set(reflect.New(embedded.Type().Elem()))
+				}
+
+				embedded = embedded.Elem()
+			}
+
+			if embedded.Kind() == reflect.Struct {
+				err := walkTaggedFields(embedded, allocate, fn)
+				if err != nil {
+					return err
+				}
+			}
+
+			continue
+		}
+
+		if tag == "-" {
+			continue
+		}
+
+		if !sf.IsExported() {
+			return fmt.Errorf("tagged field %s is unexported", sf.Name)
+		}
+
+		opts, err := parseFieldTag(tag)
+		if err != nil {
+			return err
+		}
+
+		if opts.key == "" {
+			return fmt.Errorf("tagged field %s has no key", sf.Name)
+		}
+
+		err = fn(opts, rv.Field(i))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func encodeFieldValue(rv reflect.Value, typ FieldType) (FieldValue, error) {
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return FieldValue{}, nil
+		}
+
+		rv = rv.Elem()
+	}
+
+	var value FieldValue
+
+	switch {
+	case rv.Type() == timeType:
+		value = DateValue(rv.Interface().(time.Time))
+
+	case rv.Kind() == reflect.String:
+		value = TextValue(rv.String())
+
+	case rv.Kind() == reflect.Bool:
+		value = TextValue(strconv.FormatBool(rv.Bool()))
+
+	case rv.CanInt():
+		value = NumberValue(float64(rv.Int()))
+
+	case rv.CanUint():
+		value = NumberValue(float64(rv.Uint()))
+
+	case rv.CanFloat():
+		value = NumberValue(rv.Float())
+
+	default:
+		return FieldValue{}, fmt.Errorf("unsupported type %s", rv.Type())
+	}
+
+	if typ == "" || typ == value.Type() {
+		return value, nil
+	}
+
+	if rv.Kind() == reflect.Bool && typ == Number {
+		if rv.Bool() {
+			return NumberValue(1), nil
+		}
+
+		return NumberValue(0), nil
+	}
+
+	return ParseFieldValue(typ, value.Text())
+}
+
+func decodeFieldValue(value FieldValue, rv reflect.Value) error {
+	if value.IsEmpty() {
+		rv.Set(reflect.Zero(rv.Type()))
+
+		return nil
+	}
+
+	if rv.Kind() == reflect.Ptr {
+		elem := reflect.New(rv.Type().Elem())
+
+		err := decodeFieldValue(value, elem.Elem())
+		if err != nil {
+			return err
+		}
+
+		rv.Set(elem)
+
+		return nil
+	}
+
+	if rv.Type() == timeType {
+		date, ok := value.Date()
+		if !ok {
+			parsed, err := ParseFieldValue(Date, value.Text())
+			if err != nil {
+				return err
+			}
+
+			date, _ = parsed.Date()
+		}
+
+		rv.Set(reflect.ValueOf(date))
+
+		return nil
+	}
+
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(value.Text())
+
+		return nil
+
+	case reflect.Bool:
+		if number, ok := value.Number(); ok {
+			rv.SetBool(number != 0)
+
+			return nil
+		}
+
+		b, err := strconv.ParseBool(value.Text())
+		if err != nil {
+			return fmt.Errorf("invalid boolean value %q", value.Text())
+		}
+
+		rv.SetBool(b)
+
+		return nil
+	}
+
+	number, ok := value.Number()
+	if !ok {
+		parsed, err := ParseFieldValue(Number, value.Text())
+		if err != nil {
+			return err
+		}
+
+		number, _ = parsed.Number()
+	}
+
+	switch {
+	case rv.CanInt():
+		if number != float64(int64(number)) || rv.OverflowInt(int64(number)) {
+			return fmt.Errorf("value %v does not fit in %s", number, rv.Type())
+		}
+
+		rv.SetInt(int64(number))
+
+	case rv.CanUint():
+		if number < 0 || number != float64(uint64(number)) || rv.OverflowUint(uint64(number)) {
+			return fmt.Errorf("value %v does not fit in %s", number, rv.Type())
+		}
+
+		rv.SetUint(uint64(number))
+
+	case rv.CanFloat():
+		rv.SetFloat(number)
+
+	default:
+		return fmt.Errorf("unsupported type %s", rv.Type())
+	}
+
+	return nil
+}
@@ -0,0 +1,226 @@
+package mailerlite
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Embedded test types are exported, so DecodeFields can set their fields.
+type FieldTagsBase struct {
+	Company string `mailerlite:"company"`
+}
+
+type FieldTagsExtra struct {
+	Plan string `mailerlite:"plan"`
+}
+
+type fieldTagsCustomer struct {
+	FieldTagsBase
+	*FieldTagsExtra
+
+	Name     string     `mailerlite:"name"`
+	Seats    int        `mailerlite:"seats,omitempty"`
+	Revenue  float64    `mailerlite:"revenue"`
+	Active   bool       `mailerlite:"active"`
+	Renewal  time.Time  `mailerlite:"renewal_date"`
+	Trial    *time.Time `mailerlite:"trial_date"`
+	Start    string     `mailerlite:"start_date,type=date"`
+	Zip      int        `mailerlite:"zip,type=text"`
+	Internal string     `mailerlite:"-"`
+	Untagged string
+}
+
+func TestEncodeFields(t *testing.T) {
+	renewal := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	values, err := EncodeFields(fieldTagsCustomer{
+		FieldTagsBase:  FieldTagsBase{Company: "Acme"},
+		FieldTagsExtra: &FieldTagsExtra{Plan: "gold"},
+		Name:           "John",
+		Revenue:        12.5,
+		Active:         true,
+		Renewal:        renewal,
+		Start:          "2024-01-02",
+		Zip:            1234,
+		Internal:       "secret",
+		Untagged:       "ignored",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	date, _ := ParseFieldValue(Date, "2024-01-02")
+
+	want := map[string]FieldValue{
+		"company":      TextValue("Acme"),
+		"plan":         TextValue("gold"),
+		"name":         TextValue("John"),
+		"revenue":      NumberValue(12.5),
+		"active":       TextValue("true"),
+		"renewal_date": DateValue(renewal),
+		"trial_date":   {},
+		"start_date":   date,
+		"zip":          TextValue("1234"),
+	}
+
+	if len(values) != len(want) {
+		t.Errorf("unexpected values: %v", values)
+	}
+
+	for key, value := range want {
+		if got, ok := values[key]; !ok || !got.Equal(value) || got.Type() != value.Type() {
+			t.Errorf("field %s: got %v (%s), want %v (%s)", key, got, got.Type(), value, value.Type())
+		}
+	}
+}
+
+func TestEncodeFields_SkipsEmpty(t *testing.T) {
+	values, err := EncodeFields(&fieldTagsCustomer{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := values["seats"]; ok {
+		t.Error("omitempty field should be skipped")
+	}
+
+	if _, ok := values["renewal_date"]; ok {
+		t.Error("zero time should be skipped")
+	}
+
+	if _, ok := values["plan"]; ok {
+		t.Error("fields of a nil embedded pointer should be skipped")
+	}
+
+	if value, ok := values["trial_date"]; !ok || !value.IsEmpty() {
+		t.Errorf("nil pointer should be encoded as an empty value, got %v", value)
+	}
+}
+
+func TestEncodeFields_Invalid(t *testing.T) {
+	tests := map[string]interface{}{
+		"not a struct": "john",
+		"invalid type": struct {
+			A string `mailerlite:"a,type=bool"`
+		}{},
+		"unknown": struct {
+			A string `mailerlite:"a,required"`
+		}{},
+		"missing key": struct {
+			A string `mailerlite:",omitempty"`
+		}{},
+		"unsupported": struct {
+			A []string `mailerlite:"a"`
+		}{},
+		"invalid value": struct {
+			A string `mailerlite:"a,type=number"`
+		}{A: "many"},
+	}
+
+	for name, v := range tests {
+		if _, err := EncodeFields(v); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestEncodeSubscriberFields(t *testing.T) {
+	fields, err := EncodeSubscriberFields(struct {
+		Company string `mailerlite:"company"`
+		Seats   uint   `mailerlite:"seats"`
+	}{Company: "Acme", Seats: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(fields) != 2 || fields[0].Key != "company" || fields[0].Type != Text || fields[1].Key != "seats" || fields[1].Type != Number {
+		t.Errorf("unexpected fields: %+v", fields)
+	}
+}
+
+func TestDecodeFields(t *testing.T) {
+	trial := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	var customer fieldTagsCustomer
+
+	err := DecodeFields([]SubscriberField{
+		{Key: "company", Value: TextValue("Acme")},
+		{Key: "plan", Value: TextValue("gold")},
+		{Key: "name", Value: TextValue("John")},
+		{Key: "seats", Value: NumberValue(5)},
+		{Key: "revenue", Value: TextValue("12.5")},
+		{Key: "active", Value: NumberValue(1)},
+		{Key: "trial_date", Value: DateValue(trial)},
+		{Key: "renewal_date", Value: TextValue("2024-03-01")},
+		{Key: "zip", Value: FieldValue{}},
+		{Key: "unknown", Value: TextValue("ignored")},
+	}, &customer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if customer.Company != "Acme" || customer.Name != "John" || customer.Seats != 5 || customer.Revenue != 12.5 || !customer.Active {
+		t.Errorf("unexpected customer: %+v", customer)
+	}
+
+	if customer.FieldTagsExtra == nil || customer.Plan != "gold" {
+		t.Errorf("embedded pointer should be allocated: %+v", customer.FieldTagsExtra)
+	}
+
+	if customer.Trial == nil || !customer.Trial.Equal(trial) || !customer.Renewal.Equal(trial) {
+		t.Errorf("unexpected dates: %v, %v", customer.Trial, customer.Renewal)
+	}
+}
+
+func TestDecodeFields_Numbers(t *testing.T) {
+	tests := []struct {
+		name  string
+		value FieldValue
+		v     interface{}
+	}{
+		{"overflow", NumberValue(300), &struct {
+			A int8 `mailerlite:"a"`
+		}{}},
+		{"negative unsigned", NumberValue(-1), &struct {
+			A uint `mailerlite:"a"`
+		}{}},
+		{"fraction", NumberValue(1.5), &struct {
+			A int `mailerlite:"a"`
+		}{}},
+		{"huge", NumberValue(math.MaxFloat64), &struct {
+			A int64 `mailerlite:"a"`
+		}{}},
+		{"text", TextValue("many"), &struct {
+			A int `mailerlite:"a"`
+		}{}},
+	}
+
+	for _, test := range tests {
+		err := DecodeFields([]SubscriberField{{Key: "a", Value: test.value}}, test.v)
+		if err == nil || !strings.Contains(err.Error(), `"a"`) {
+			t.Errorf("%s: expected an error, got %v", test.name, err)
+		}
+	}
+}
+
+func TestDecodeFields_RequiresPointer(t *testing.T) {
+	if err := DecodeFields(nil, fieldTagsCustomer{}); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+type fieldTagsHidden struct {
+	Plan string `mailerlite:"plan"`
+}
+
+func TestDecodeFields_UnexportedEmbedded(t *testing.T) {
+	var v struct {
+		*fieldTagsHidden
+	}
+
+	if err := DecodeFields([]SubscriberField{{Key: "plan", Value: TextValue("gold")}}, &v); err == nil {
+		t.Error("expected an error")
+	}
+}
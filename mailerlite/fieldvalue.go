@@ -0,0 +1,274 @@
+package mailerlite
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// fieldDateLayout is the format of date field values.
+const fieldDateLayout = "2006-01-02"
+
+// FieldValue is the value of a custom field.
+// The zero value represents an empty (null) value.
+type FieldValue struct {
+	typ    FieldType
+	text   string
+	number float64
+	date   time.Time
+}
+
+// TextValue returns a new TEXT field value.
+func TextValue(text string) FieldValue {
+	return FieldValue{typ: Text, text: text}
+}
+
+// NumberValue returns a new NUMBER field value.
+func NumberValue(number float64) FieldValue {
+	return FieldValue{typ: Number, number: number}
+}
+
+// DateValue returns a new DATE field value.
+// Only the date part of t is kept (in its own location).
+func DateValue(t time.Time) FieldValue {
+	year, month, day := t.Date()
+
+	return FieldValue{typ: Date, date: time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
+
+// ParseFieldValue parses the string representation of a field value of the given type.
+// Dates are expected in YYYY-MM-DD format (optionally followed by a time of the day).
+// An empty string results in an empty value.
+func ParseFieldValue(typ FieldType, s string) (FieldValue, error) {
+	if s == "" {
+		return FieldValue{}, nil
+	}
+
+	switch typ {
+	case Text:
+		return TextValue(s), nil
+
+	case Number:
+		number, err := strconv.ParseFloat(s, 64)
+		if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+			return FieldValue{}, fmt.Errorf("invalid number field value %q", s)
+		}
+
+		return NumberValue(number), nil
+
+	case Date:
+		date, err := time.Parse(fieldDateLayout, s)
+		if err != nil {
+			date, err = time.Parse("2006-01-02 15:04:05", s)
+		}
+		if err != nil {
+			return FieldValue{}, fmt.Errorf("invalid date field value %q (expected YYYY-MM-DD)", s)
+		}
+
+		return DateValue(date), nil
+	}
+
+	return FieldValue{}, fmt.Errorf("unknown field type %q", typ)
+}
+
+// Type returns the type of the value (empty for empty values).
+func (v FieldValue) Type() FieldType {
+	return v.typ
+}
+
+// IsEmpty reports whether the value is empty.
+func (v FieldValue) IsEmpty() bool {
+	return v.typ == ""
+}
+
+// Text returns the value formatted the way the API represents it.
+func (v FieldValue) Text() string {
+	switch v.typ {
+	case Text:
+		return v.text
+
+	case Number:
+		return strconv.FormatFloat(v.number, 'f', -1, 64)
+
+	case Date:
+		return v.date.Format(fieldDateLayout)
+	}
+
+	return ""
+}
+
+// Number returns the value of a NUMBER field.
+func (v FieldValue) Number() (float64, bool) {
+	return v.number, v.typ == Number
+}
+
+// Date returns the value of a DATE field (at midnight UTC).
+func (v FieldValue) Date() (time.Time, bool) {
+	return v.date, v.typ == Date
+}
+
+func (v FieldValue) String() string {
+	return v.Text()
+}
+
+// Equal reports whether v and u hold the same value.
+// Empty texts are equal to empty values, since the API does not distinguish them.
+func (v FieldValue) Equal(u FieldValue) bool {
+	if v.blank() || u.blank() {
+		return v.blank() && u.blank()
+	}
+
+	return v.typ == u.typ && v.Text() == u.Text()
+}
+
+// blank reports whether the value is empty or an empty text.
+func (v FieldValue) blank() bool {
+	return v.typ == "" || (v.typ == Text && v.text == "")
+}
+
+// Validate checks that the value can be stored in a field.
+func (v FieldValue) Validate(field Field) error {
+	if v.typ == Number && !v.finite() {
+		return fmt.Errorf("field %q: number value must be finite, got %v", field.Key, v.number)
+	}
+
+	if v.IsEmpty() || v.typ == field.Type {
+		return nil
+	}
+
+	return &FieldValueError{
+		Key:      string(field.Key),
+		Expected: field.Type,
+		Actual:   v.typ,
+	}
+}
+
+// FieldValueError is returned when a value does not match the type of a field.
+type FieldValueError struct {
+	Key      string
+	Expected FieldType
+	Actual   FieldType
+}
+
+func (e *FieldValueError) Error() string {
+	if e.Expected == "" {
+		return fmt.Sprintf("unknown field %q", e.Key)
+	}
+
+	return fmt.Sprintf("field %q expects a %s value, got %s", e.Key, e.Expected, e.Actual)
+}
+
+// ValidateFieldValues checks a set of values (keyed by field key) against the field schema of an account.
+func ValidateFieldValues(fields []Field, values map[string]FieldValue) error {
+	schema := make(map[string]Field, len(fields))
+	for _, field := range fields {
+		schema[string(field.Key)] = field
+	}
+
+	for key, value := range values {
+		field, ok := schema[key]
+		if !ok {
+			return &FieldValueError{Key: key, Actual: value.typ}
+		}
+
+		if err := value.Validate(field); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// finite reports whether a NUMBER value can be represented in JSON.
+func (v FieldValue) finite() bool {
+	return !math.IsNaN(v.number) && !math.IsInf(v.number, 0)
+}
+
+// ValidateFields configures a Client to validate the custom field values passed to
+// SubscribersService.Create, SubscribersService.Update and GroupsService.AddSubscriber
+// against the fields of the account (see ValidateFieldValues).
+// Invalid values are rejected with a *FieldValueError without sending the write request.
+//
+// The fields are fetched with FieldsService.List before each write:
+// use ResponseCache to avoid sending an extra request every time.
+func ValidateFields() ClientOption {
+	return clientOptionFunc(func(c *Client) {
+		c.validateFields = true
+	})
+}
+
+// checkFieldValues validates field values if the Client is configured to validate fields.
+func (c *Client) checkFieldValues(ctx context.Context, values map[string]FieldValue) error {
+	if !c.validateFields || len(values) == 0 {
+		return nil
+	}
+
+	fields, _, err := c.Fields.List(ctx)
+	if err != nil {
+		return fmt.Errorf("listing fields: %w", err)
+	}
+
+	return ValidateFieldValues(fields, values)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// Numbers are encoded as JSON numbers, texts and dates (formatted as YYYY-MM-DD) as JSON strings.
+// NaN and infinite numbers cannot be encoded.
+func (v FieldValue) MarshalJSON() ([]byte, error) {
+	switch v.typ {
+	case "":
+		return []byte("null"), nil
+
+	case Number:
+		if !v.finite() {
+			return nil, &json.UnsupportedValueError{
+				Value: reflect.ValueOf(v.number),
+				Str:   strconv.FormatFloat(v.number, 'g', -1, 64),
+			}
+		}
+
+		return []byte(v.Text()), nil
+	}
+
+	return json.Marshal(v.Text())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// JSON numbers are decoded as NUMBER, non-empty strings as TEXT values.
+// Use ParseFieldValue to interpret a string according to the type of the field.
+func (v *FieldValue) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+
+	if bytes.Equal(data, []byte("null")) {
+		*v = FieldValue{}
+
+		return nil
+	}
+
+	var number float64
+	if err := json.Unmarshal(data, &number); err == nil {
+		*v = NumberValue(number)
+
+		return nil
+	}
+
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+
+	if text == "" {
+		*v = FieldValue{}
+
+		return nil
+	}
+
+	*v = TextValue(text)
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package mailerlite
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"testing"
+)
+
+func TestFieldValue_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		value FieldValue
+		want  string
+	}{
+		{FieldValue{}, `null`},
+		{TextValue("gold"), `"gold"`},
+		{NumberValue(12.5), `12.5`},
+		{NumberValue(1e21), `1000000000000000000000`},
+	}
+
+	for _, test := range tests {
+		got, err := json.Marshal(test.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(got) != test.want {
+			t.Errorf("got %s, want %s", got, test.want)
+		}
+	}
+}
+
+func TestFieldValue_MarshalJSON_NonFinite(t *testing.T) {
+	for _, number := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		_, err := json.Marshal(NumberValue(number))
+
+		var unsupported *json.UnsupportedValueError
+		if !errors.As(err, &unsupported) {
+			t.Errorf("%v: expected *json.UnsupportedValueError, got %v", number, err)
+		}
+
+		if err := NumberValue(number).Validate(Field{Key: "seats", Type: Number}); err == nil {
+			t.Errorf("%v: expected validation error", number)
+		}
+	}
+
+	if _, err := ParseFieldValue(Number, "NaN"); err == nil {
+		t.Error("expected NaN to be rejected")
+	}
+}
+
+func TestValidateFields(t *testing.T) {
+	client, mux := setup(t, ValidateFields())
+
+	mux.HandleFunc("/fields", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"id":1,"title":"Seats","key":"seats","type":"NUMBER"}]`))
+	})
+	mux.HandleFunc("/subscribers", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("invalid values should not be sent")
+	})
+
+	ctx := context.Background()
+
+	tests := map[string]map[string]FieldValue{
+		"type mismatch": {"seats": TextValue("five")},
+		"unknown field": {"plan": TextValue("gold")},
+	}
+
+	for name, fields := range tests {
+		fields := fields
+
+		t.Run(name, func(t *testing.T) {
+			_, _, err := client.Subscribers.Create(ctx, NewSubscriber{Email: "john@example.com", Fields: fields})
+
+			var valueErr *FieldValueError
+			if !errors.As(err, &valueErr) {
+				t.Fatalf("expected *FieldValueError, got %v", err)
+			}
+		})
+	}
+}
+
+func TestFieldValue_Equal(t *testing.T) {
+	tests := []struct {
+		v, u FieldValue
+		want bool
+	}{
+		{FieldValue{}, FieldValue{}, true},
+		{TextValue(""), FieldValue{}, true},
+		{FieldValue{}, TextValue(""), true},
+		{TextValue("a"), TextValue("a"), true},
+		{TextValue("a"), FieldValue{}, false},
+		{NumberValue(5), NumberValue(5), true},
+		{NumberValue(5), TextValue("5"), false},
+	}
+
+	for _, test := range tests {
+		if got := test.v.Equal(test.u); got != test.want {
+			t.Errorf("%#v.Equal(%#v): got %t, want %t", test.v, test.u, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package mailerlite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// FormsService handles communication with the form related
+// methods of the MailerLite API.
+//
+// Forms are only available in the Connect API (see ConnectBaseURL).
+//
+// MailerLite API docs: https://developers.mailerlite.com/docs/forms.html
+type FormsService service
+
+// Form represents a popup, an embedded form or a promotion collecting signups.
+type Form struct {
+	ID                 WeakInt    `json:"id"`
+	Type               FormType   `json:"type"`
+	Slug               string     `json:"slug"`
+	Name               string     `json:"name"`
+	Active             WeakBool   `json:"active"`
+	OpensCount         int        `json:"opens_count"`
+	ConversionsCount   int        `json:"conversions_count"`
+	ConversionRate     Ratio      `json:"conversion_rate"`
+	DoubleOptin        WeakBool   `json:"double_optin"`
+	LastRegistrationAt *Timestamp `json:"last_registration_at"`
+	CreatedAt          Timestamp  `json:"created_at"`
+
+	// Groups new subscribers are added to.
+	Groups []FormGroup `json:"groups"`
+
+	Raw json.RawMessage `json:"-"` // Raw JSON of the response, see RetainRaw.
+}
+
+// FormGroup identifies a group a form adds subscribers to.
+type FormGroup struct {
+	ID   WeakInt `json:"id"`
+	Name string  `json:"name"`
+}
+
+// FormType represents the kind of a form.
+type FormType string
+
+const (
+	PopupForm     FormType = "popup"
+	EmbeddedForm  FormType = "embedded"
+	PromotionForm FormType = "promotion"
+)
+
+// validate checks that the form type is one of the types known by the API.
+func (t FormType) validate() error {
+	switch t {
+	case PopupForm, EmbeddedForm, PromotionForm:
+		return nil
+	}
+
+	return fmt.Errorf("invalid form type %q", t)
+}
+
+// FormListOptions specifies the optional parameters to the
+// FormsService.List method.
+type FormListOptions struct {
+	// List only forms whose name contains Name.
+	Name string `url:"filter[name],omitempty"`
+
+	PageOptions
+}
+
+// List forms of a given type.
+//
+// MailerLite API docs: https://developers.mailerlite.com/docs/forms.html#list-all-forms
+func (s *FormsService) List(ctx context.Context, typ FormType, opts *FormListOptions, callOpts ...CallOption) ([]Form, *Response, error) {
+	ctx = withOperation(ctx, "FormsService.List")
+
+	if err := typ.validate(); err != nil {
+		return nil, nil, err
+	}
+
+	u := fmt.Sprintf("forms/%s", typ)
+
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.newConnectRequest(ctx, http.MethodGet, u, nil, callOpts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	forms, resp, err := connectDo[[]Form](s.client, req)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return forms, resp, nil
+}
+
+// Get fetches a form.
+//
+// MailerLite API docs: https://developers.mailerlite.com/docs/forms.html#get-a-form
+func (s *FormsService) Get(ctx context.Context, id int, callOpts ...CallOption) (*Form, *Response, error) {
+	ctx = withOperation(ctx, "FormsService.Get")
+
+	u := fmt.Sprintf("forms/%d", id)
+
+	req, err := s.client.newConnectRequest(ctx, http.MethodGet, u, nil, callOpts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	form, resp, err := connectDo[*Form](s.client, req)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return form, resp, nil
+}
+
+// FormSubscriber is a subscriber who signed up via a form.
+type FormSubscriber struct {
+	ID           WeakInt               `json:"id"`
+	Email        string                `json:"email"`
+	Status       string                `json:"status"`
+	Source       string                `json:"source"`
+	Fields       map[string]FieldValue `json:"fields"`
+	SubscribedAt *Timestamp            `json:"subscribed_at"`
+	CreatedAt    Timestamp             `json:"created_at"`
+
+	Raw json.RawMessage `json:"-"` // Raw JSON of the response, see RetainRaw.
+}
+
+// FormSubscriberListOptions specifies the optional parameters to the
+// FormsService.Subscribers method.
+type FormSubscriberListOptions struct {
+	// List only subscribers with the status (active, unsubscribed, unconfirmed, bounced or junk).
+	Status string `url:"filter[status],omitempty"`
+
+	PageOptions
+}
+
+// Subscribers lists the subscribers who signed up via a form.
+//
+// MailerLite API docs: https://developers.mailerlite.com/docs/forms.html#get-subscribers-who-signed-up-to-a-specific-form
+func (s *FormsService) Subscribers(ctx context.Context, id int, opts *FormSubscriberListOptions, callOpts ...CallOption) ([]FormSubscriber, *Response, error) {
+	ctx = withOperation(ctx, "FormsService.Subscribers")
+
+	req, err := s.subscribersRequest(ctx, id, opts, callOpts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	subscribers, resp, err := connectDo[[]FormSubscriber](s.client, req)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return subscribers, resp, nil
+}
+
+// SubscribersEach lists subscribers of a form like Subscribers, but calls fn for each subscriber as it is decoded
+// instead of holding the whole page in memory.
+// Return ErrStopStream from fn to stop early.
+func (s *FormsService) SubscribersEach(ctx context.Context, id int, opts *FormSubscriberListOptions, fn func(subscriber FormSubscriber) error, callOpts ...CallOption) (*Response, error) {
+	ctx = withOperation(ctx, "FormsService.SubscribersEach")
+
+	req, err := s.subscribersRequest(ctx, id, opts, callOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return connectStream(s.client, req, fn)
+}
+
+func (s *FormsService) subscribersRequest(ctx context.Context, id int, opts *FormSubscriberListOptions, callOpts ...CallOption) (*http.Request, error) {
+	u := fmt.Sprintf("forms/%d/subscribers", id)
+
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.newConnectRequest(ctx, http.MethodGet, u, nil, callOpts...)
+}
@@ -0,0 +1,81 @@
+package mailerlite
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestFormsService_List(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/forms/popup", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		_, _ = w.Write([]byte(`{"data": [{
+			"id": "1",
+			"type": "popup",
+			"name": "Newsletter",
+			"conversions_count": 5,
+			"conversion_rate": {"float": 0.05, "string": "5%"},
+			"created_at": "2022-01-01 10:00:00",
+			"groups": [{"id": "2", "name": "Customers"}, {"id": "3", "name": "Newsletter"}]
+		}]}`))
+	})
+
+	forms, _, err := client.Forms.List(context.Background(), PopupForm, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(forms) != 1 || forms[0].ID != 1 || forms[0].ConversionsCount != 5 || forms[0].ConversionRate.Float != 0.05 {
+		t.Fatalf("unexpected forms: %+v", forms)
+	}
+
+	wantGroups := []FormGroup{{ID: 2, Name: "Customers"}, {ID: 3, Name: "Newsletter"}}
+	if got := forms[0].Groups; !reflect.DeepEqual(got, wantGroups) {
+		t.Errorf("groups: got %+v, want %+v", got, wantGroups)
+	}
+}
+
+func TestFormsService_List_InvalidType(t *testing.T) {
+	client, _ := setup(t)
+
+	_, _, err := client.Forms.List(context.Background(), FormType("../subscribers"), nil)
+	if err == nil {
+		t.Fatal("expected an error for an invalid form type")
+	}
+}
+
+func TestFormsService_SubscribersEach(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/forms/1/subscribers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		_, _ = w.Write([]byte(`{
+			"links": {"next": null},
+			"data": [
+				{"id": "2", "email": "john@example.com", "status": "active", "fields": {"city": "Budapest"}},
+				{"id": "3", "email": "jane@example.com", "status": "active"}
+			],
+			"meta": {"current_page": 1}
+		}`))
+	})
+
+	var emails []string
+
+	_, err := client.Forms.SubscribersEach(context.Background(), 1, nil, func(subscriber FormSubscriber) error {
+		emails = append(emails, subscriber.Email)
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(emails) != 2 || emails[0] != "john@example.com" || emails[1] != "jane@example.com" {
+		t.Errorf("unexpected subscribers: %v", emails)
+	}
+}
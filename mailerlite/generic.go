@@ -0,0 +1,62 @@
+package mailerlite
+
+import (
+	"context"
+	"net/http"
+)
+
+// Get sends a GET request to an endpoint without a dedicated method and decodes the response into T.
+// path is relative to the base URL (without a preceding slash),
+// query is an optional struct encoded as URL query parameters (see ListOptions),
+// merged with the query of path (if any).
+func Get[T any](ctx context.Context, c *Client, path string, query interface{}, callOpts ...CallOption) (T, *Response, error) {
+	return call[T](withOperation(ctx, "Client.Get"), c, http.MethodGet, path, query, nil, callOpts)
+}
+
+// Post sends a POST request with a JSON encoded body to an endpoint without a dedicated method
+// and decodes the response into T. See Get for details.
+func Post[T any](ctx context.Context, c *Client, path string, query interface{}, body interface{}, callOpts ...CallOption) (T, *Response, error) {
+	return call[T](withOperation(ctx, "Client.Post"), c, http.MethodPost, path, query, body, callOpts)
+}
+
+// Put sends a PUT request with a JSON encoded body to an endpoint without a dedicated method
+// and decodes the response into T. See Get for details.
+func Put[T any](ctx context.Context, c *Client, path string, query interface{}, body interface{}, callOpts ...CallOption) (T, *Response, error) {
+	return call[T](withOperation(ctx, "Client.Put"), c, http.MethodPut, path, query, body, callOpts)
+}
+
+// Delete sends a DELETE request to an endpoint without a dedicated method. See Get for details.
+func Delete(ctx context.Context, c *Client, path string, query interface{}, callOpts ...CallOption) (*Response, error) {
+	req, err := newGenericRequest(withOperation(ctx, "Client.Delete"), c, http.MethodDelete, path, query, nil, callOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Do(req, nil)
+}
+
+func call[T any](ctx context.Context, c *Client, method string, path string, query interface{}, body interface{}, callOpts []CallOption) (T, *Response, error) {
+	var v T
+
+	req, err := newGenericRequest(ctx, c, method, path, query, body, callOpts)
+	if err != nil {
+		return v, nil, err
+	}
+
+	resp, err := c.Do(req, &v)
+
+	return v, resp, err
+}
+
+func newGenericRequest(ctx context.Context, c *Client, method string, path string, query interface{}, body interface{}, callOpts []CallOption) (*http.Request, error) {
+	if query != nil {
+		var err error
+
+		path, err = addOptions(path, query)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return c.NewRequest(ctx, method, path, body, callOpts...)
+}
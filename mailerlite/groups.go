@@ -2,6 +2,7 @@ package mailerlite
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 )
@@ -12,23 +13,148 @@ import (
 // MailerLite API docs: https://developers.mailerlite.com/reference/groups
 type GroupsService service
 
+// Group represents a group of subscribers.
+type Group struct {
+	ID           int        `json:"id"`
+	Name         string     `json:"name"`
+	Total        int        `json:"total"`
+	Active       int        `json:"active"`
+	Unsubscribed int        `json:"unsubscribed"`
+	Bounced      int        `json:"bounced"`
+	Unconfirmed  int        `json:"unconfirmed"`
+	Junk         int        `json:"junk"`
+	Sent         int        `json:"sent"`
+	Opened       int        `json:"opened"`
+	Clicked      int        `json:"clicked"`
+	DateCreated  Timestamp  `json:"date_created"`
+	DateUpdated  *Timestamp `json:"date_updated"`
+
+	Raw json.RawMessage `json:"-"` // Raw JSON of the response, see RetainRaw.
+}
+
+// GroupListOptions specifies the optional parameters to the
+// GroupsService.List method.
+type GroupListOptions struct {
+	ListOptions
+}
+
+// List all groups.
+//
+// MailerLite API docs: https://developers.mailerlite.com/reference/groups
+func (s *GroupsService) List(ctx context.Context, opts *GroupListOptions, callOpts ...CallOption) ([]Group, *Response, error) {
+	ctx = withOperation(ctx, "GroupsService.List")
+
+	u := "groups"
+
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil, callOpts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var groups []Group
+	resp, err := s.client.Do(req, &groups)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return groups, resp, nil
+}
+
+// GroupSubscriberListOptions specifies the optional parameters to the
+// GroupsService.Subscribers method.
+type GroupSubscriberListOptions struct {
+	// List only subscribers of this type.
+	Type SubscriptionType `url:"-"`
+
+	ListOptions
+}
+
+// Subscribers lists the subscribers of a group.
+//
+// MailerLite API docs: https://developers.mailerlite.com/reference/subscribers-in-a-group
+func (s *GroupsService) Subscribers(ctx context.Context, id int, opts *GroupSubscriberListOptions, callOpts ...CallOption) ([]Subscriber, *Response, error) {
+	ctx = withOperation(ctx, "GroupsService.Subscribers")
+
+	req, err := s.subscribersRequest(ctx, id, opts, callOpts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var subscribers []Subscriber
+	resp, err := s.client.Do(req, &subscribers)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return subscribers, resp, nil
+}
+
+// SubscribersEach lists subscribers of a group like Subscribers, but calls fn for each subscriber as it is decoded
+// instead of holding the whole page in memory.
+// Return ErrStopStream from fn to stop early.
+func (s *GroupsService) SubscribersEach(ctx context.Context, id int, opts *GroupSubscriberListOptions, fn func(subscriber Subscriber) error, callOpts ...CallOption) (*Response, error) {
+	ctx = withOperation(ctx, "GroupsService.SubscribersEach")
+
+	req, err := s.subscribersRequest(ctx, id, opts, callOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return Stream(s.client, req, fn)
+}
+
+func (s *GroupsService) subscribersRequest(ctx context.Context, id int, opts *GroupSubscriberListOptions, callOpts ...CallOption) (*http.Request, error) {
+	u := fmt.Sprintf("groups/%d/subscribers", id)
+	if opts != nil && opts.Type != "" {
+		u = fmt.Sprintf("groups/%d/subscribers/%s", id, opts.Type)
+	}
+
+	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.NewRequest(ctx, http.MethodGet, u, nil, callOpts...)
+}
+
 // NewSubscriberInGroup represents a new subscriber in a group.
 type NewSubscriberInGroup struct {
-	Email          string            `json:"email,omitempty"`
-	Name           string            `json:"name,omitempty"`
-	Fields         map[string]string `json:"fields,omitempty"`
-	Resubscribe    *bool             `json:"resubscribe,omitempty"`
-	AutoResponders *bool             `json:"autoresponders,omitempty"`
-	Type           SubscriptionType  `json:"type,omitempty"`
+	Email          string                `json:"email,omitempty"`
+	Name           string                `json:"name,omitempty"`
+	Fields         map[string]FieldValue `json:"fields,omitempty"`
+	Resubscribe    *bool                 `json:"resubscribe,omitempty"`
+	AutoResponders *bool                 `json:"autoresponders,omitempty"`
+	Type           SubscriptionType      `json:"type,omitempty"`
 }
 
 // Add a subscriber to a group.
 //
 // MailerLite API docs: https://developers.mailerlite.com/reference/add-single-subscriber
-func (s *GroupsService) AddSubscriber(ctx context.Context, id int, newSubscriber NewSubscriberInGroup) (*Subscriber, *Response, error) {
+func (s *GroupsService) AddSubscriber(ctx context.Context, id int, newSubscriber NewSubscriberInGroup, callOpts ...CallOption) (*Subscriber, *Response, error) {
+	ctx = withOperation(ctx, "GroupsService.AddSubscriber")
+
+	email, err := s.client.validateEmail(newSubscriber.Email)
+	if err != nil {
+		return nil, nil, err
+	}
+	newSubscriber.Email = email
+
+	if err := s.client.checkSuppressed(ctx, newSubscriber.Email); err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.client.checkFieldValues(ctx, newSubscriber.Fields); err != nil {
+		return nil, nil, err
+	}
+
 	u := fmt.Sprintf("groups/%d/subscribers", id)
 
-	req, err := s.client.NewRequest(ctx, http.MethodPost, u, newSubscriber)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, u, newSubscriber, callOpts...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -41,3 +167,19 @@ func (s *GroupsService) AddSubscriber(ctx context.Context, id int, newSubscriber
 
 	return &subscriber, resp, nil
 }
+
+// RemoveSubscriber removes a subscriber from a group.
+//
+// MailerLite API docs: https://developers.mailerlite.com/reference/remove-subscriber
+func (s *GroupsService) RemoveSubscriber(ctx context.Context, id int, email string, callOpts ...CallOption) (*Response, error) {
+	ctx = withOperation(ctx, "GroupsService.RemoveSubscriber")
+
+	u := fmt.Sprintf("groups/%d/subscribers/%s", id, pathEscape(email))
+
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, u, nil, callOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,55 @@
+package mailerlite
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGroupsService_Subscribers(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/groups/1/subscribers/unsubscribed", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		if got, want := r.URL.RawQuery, "limit=10&offset=20"; got != want {
+			t.Errorf("query: got %s, want %s", got, want)
+		}
+
+		_, _ = w.Write([]byte(`[{"id":1,"email":"john@example.com","type":"unsubscribed"}]`))
+	})
+
+	subscribers, _, err := client.Groups.Subscribers(context.Background(), 1, &GroupSubscriberListOptions{
+		Type:        Unsubscribed,
+		ListOptions: ListOptions{Offset: 20, Limit: 10},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(subscribers) != 1 || subscribers[0].Email != "john@example.com" {
+		t.Errorf("unexpected subscribers: %+v", subscribers)
+	}
+}
+
+func TestGroupsService_RemoveSubscriber(t *testing.T) {
+	client, mux := setup(t)
+
+	var called bool
+	mux.HandleFunc("/groups/1/subscribers/john@example.com", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+
+		called = true
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Groups.RemoveSubscriber(context.Background(), 1, "john@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !called {
+		t.Error("expected a request to be sent")
+	}
+}
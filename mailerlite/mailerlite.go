@@ -4,13 +4,16 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"io/ioutil"
 	"net/http"
 	"net/url"
 	"reflect"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/go-querystring/query"
 )
@@ -19,7 +22,9 @@ const (
 	defaultBaseURL = "https://api.mailerlite.com/api/v2/"
 	userAgent      = "go-mailerlite"
 
-	headerAPIKey = "X-MailerLite-ApiKey" // nolint: gosec
+	headerAPIKey             = "X-MailerLite-ApiKey" // nolint: gosec
+	headerRateLimitLimit     = "X-RateLimit-Limit"
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
 )
 
 // A Client manages communication with the MailerLite API.
@@ -31,23 +36,63 @@ type Client struct {
 	// BaseURL should always be specified with a trailing slash.
 	baseURL *url.URL
 
+	// Base URL for requests to the Connect API (API v3).
+	// Defaults to the public MailerLite Connect API.
+	connectBaseURL *url.URL
+
 	// User agent used when communicating with the MailerLite API.
 	userAgent string
 
 	// API key that authenticates each request sent to the API.
 	apiKey string
 
+	// Token that authenticates requests sent to the Connect API (defaults to apiKey).
+	connectToken string
+
+	// Maximum number of times a failed request is retried.
+	maxRetries int
+
+	// Observers receiving instrumentation events about API calls.
+	observers []Observer
+
+	// Cache for responses of read-mostly endpoints (optional).
+	cache *responseCache
+
+	// Checker preventing suppressed email addresses from being added (optional).
+	suppressionChecker SuppressionChecker
+
+	// Validator of email addresses passed to subscriber writes (optional).
+	emailValidator *EmailValidator
+
+	// Validate custom field values of subscriber writes against the fields of the account.
+	validateFields bool
+
+	// Time zone of timestamps without time zone information in responses (optional).
+	location *time.Location
+
+	// Callback receiving differences between responses and models (optional).
+	decodeWarnings func(w DecodeWarning)
+
+	// Keep the raw JSON of models.
+	retainRaw bool
+
+	// Policy masking personal data in errors and observed URLs (optional).
+	redact RedactionPolicy
+
 	common service // Reuse a single struct instead of allocating one for each service on the heap.
 
 	// Services used for talking to different parts of the MailerLite API.
-	Campaigns   *CampaignsService
-	Segments    *SegmentsService
-	Subscribers *SubscribersService
-	Groups      *GroupsService
-	Fields      *FieldsService
-	Webhooks    *WebhooksService
-	Stats       *StatsService
-	Settings    *SettingsService
+	Campaigns    *CampaignsService
+	Automations  *AutomationsService
+	Forms        *FormsService
+	Segments     *SegmentsService
+	Subscribers  *SubscribersService
+	Groups       *GroupsService
+	Fields       *FieldsService
+	Webhooks     *WebhooksService
+	Stats        *StatsService
+	Settings     *SettingsService
+	Suppressions *SuppressionsService
 }
 
 type service struct {
@@ -101,14 +146,20 @@ func UserAgent(userAgent string) ClientOption {
 }
 
 // NewClient returns a new MailerLite API client.
+//
+// apiKey authenticates requests sent to the MailerLite API (API v2).
+// It is also sent as the bearer token of requests to the Connect API (API v3),
+// unless a different token is configured with ConnectToken.
 func NewClient(apiKey string, opts ...ClientOption) (*Client, error) {
 	baseURL, _ := url.Parse(defaultBaseURL)
+	connectBaseURL, _ := url.Parse(defaultConnectBaseURL)
 
 	c := &Client{
-		httpClient: http.DefaultClient,
-		baseURL:    baseURL,
-		userAgent:  userAgent,
-		apiKey:     apiKey,
+		httpClient:     http.DefaultClient,
+		baseURL:        baseURL,
+		connectBaseURL: connectBaseURL,
+		userAgent:      userAgent,
+		apiKey:         apiKey,
 	}
 
 	for _, opt := range opts {
@@ -119,10 +170,20 @@ func NewClient(apiKey string, opts ...ClientOption) (*Client, error) {
 		return nil, fmt.Errorf("base URL must have a trailing slash, but %q does not", c.baseURL)
 	}
 
+	if !strings.HasSuffix(c.connectBaseURL.Path, "/") {
+		return nil, fmt.Errorf("connect base URL must have a trailing slash, but %q does not", c.connectBaseURL)
+	}
+
+	if c.cache != nil {
+		c.cache.account = cacheAccount(c.apiKey)
+	}
+
 	c.common.client = c
 
 	// Services
 	c.Campaigns = (*CampaignsService)(&c.common)
+	c.Automations = (*AutomationsService)(&c.common)
+	c.Forms = (*FormsService)(&c.common)
 	c.Segments = (*SegmentsService)(&c.common)
 	c.Subscribers = (*SubscribersService)(&c.common)
 	c.Groups = (*GroupsService)(&c.common)
@@ -130,6 +191,7 @@ func NewClient(apiKey string, opts ...ClientOption) (*Client, error) {
 	c.Webhooks = (*WebhooksService)(&c.common)
 	c.Stats = (*StatsService)(&c.common)
 	c.Settings = (*SettingsService)(&c.common)
+	c.Suppressions = (*SuppressionsService)(&c.common)
 
 	return c, nil
 }
@@ -145,9 +207,17 @@ type ListOptions struct {
 // in which case it is resolved relative to the baseURL of the Client.
 // Relative URLs should always be specified without a preceding slash. If
 // specified, the value pointed to by body is JSON encoded and included as the
-// request body.
-func (c *Client) NewRequest(ctx context.Context, method string, urlStr string, body interface{}) (*http.Request, error) {
-	u, err := c.baseURL.Parse(urlStr)
+// request body. Call options are stored in the context of the request and
+// honored by Do.
+func (c *Client) NewRequest(ctx context.Context, method string, urlStr string, body interface{}, opts ...CallOption) (*http.Request, error) {
+	o := newCallOptions(opts)
+
+	baseURL := c.baseURL
+	if o.baseURL != nil {
+		baseURL = o.baseURL
+	}
+
+	u, err := baseURL.Parse(urlStr)
 	if err != nil {
 		return nil, err
 	}
@@ -165,6 +235,10 @@ func (c *Client) NewRequest(ctx context.Context, method string, urlStr string, b
 		}
 	}
 
+	if len(opts) > 0 {
+		ctx = context.WithValue(ctx, callOptionsContextKey{}, o)
+	}
+
 	req, err := http.NewRequestWithContext(ctx, method, u.String(), buf)
 	if err != nil {
 		return nil, err
@@ -182,6 +256,14 @@ func (c *Client) NewRequest(ctx context.Context, method string, urlStr string, b
 		req.Header.Set("User-Agent", c.userAgent)
 	}
 
+	for key, values := range o.header {
+		req.Header[key] = append([]string(nil), values...)
+	}
+
+	if o.idempotencyKey != "" {
+		req.Header.Set(headerIdempotencyKey, o.idempotencyKey)
+	}
+
 	return req, nil
 }
 
@@ -190,74 +272,202 @@ func (c *Client) NewRequest(ctx context.Context, method string, urlStr string, b
 // error if an API error has occurred. If v implements the io.Writer interface,
 // the raw response body will be written to v, without attempting to first
 // decode it. If v is nil, and no error hapens, the response is returned as is.
+// If the Client is configured with a ResponseCache, the response may be served
+// from the cache.
 func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
-	resp, err := c.BareDo(req)
+	return c.do(req, c.cachedDo, func(body io.Reader) error {
+		switch v := v.(type) {
+		case nil:
+			return nil
+
+		case io.Writer:
+			_, err := io.Copy(v, body)
+
+			return err
+
+		default:
+			return c.decodeNext(req.Context(), json.NewDecoder(body), v)
+		}
+	})
+}
+
+// do sends an API request using send and passes the body of a successful response to read.
+// read may return ErrStopStream to stop reading early: the rest of the body is neither read nor captured.
+func (c *Client) do(req *http.Request, send func(req *http.Request) (*Response, error), read func(body io.Reader) error) (*Response, error) {
+	o := callOptionsFromContext(req.Context())
+
+	// WithTimeout is applied by BareDo (which send ends up calling), and covers reading the body until it is closed below.
+	resp, err := send(req)
 	if err != nil {
+		var errorResponse *ErrorResponse
+		if o.capture != nil && errors.As(err, &errorResponse) {
+			_, _ = o.capture.Write(errorResponse.Body)
+		}
+
 		return resp, err
 	}
 	defer resp.Body.Close()
 
-	switch v := v.(type) {
-	case nil:
-
-	case io.Writer:
-		_, err = io.Copy(v, resp.Body)
+	if o.capture == nil {
+		err = read(resp.Body)
+	} else {
+		body := io.TeeReader(resp.Body, o.capture)
 
-	default:
-		decErr := json.NewDecoder(resp.Body).Decode(v)
-		if decErr == io.EOF { // nolint: errorlint
-			decErr = nil // ignore EOF errors caused by empty response body
+		err = read(body)
+		if err == nil {
+			_, err = io.Copy(io.Discard, body) // capture the rest of the body
 		}
+	}
 
-		if decErr != nil {
-			err = decErr
-		}
+	if errors.Is(err, ErrStopStream) {
+		return resp, nil
 	}
 
 	return resp, err
 }
 
+// decodeNext decodes the next JSON value from dec into v according to the decoding options of the Client.
+func (c *Client) decodeNext(ctx context.Context, dec *json.Decoder, v interface{}) error {
+	var err error
+
+	if c.decodeWarnings != nil || c.retainRaw {
+		var data json.RawMessage
+
+		err = dec.Decode(&data)
+		if err == nil {
+			err = c.decode(ctx, data, v)
+		}
+	} else {
+		err = dec.Decode(v)
+	}
+
+	if err == io.EOF { // nolint: errorlint
+		err = nil // ignore EOF errors caused by empty response body
+	}
+
+	if err == nil && c.location != nil {
+		localizeTimestamps(reflect.ValueOf(v), c.location)
+	}
+
+	return err
+}
+
 // Response is a MailerLite API response. This wraps the standard http.Response
 // returned from MailerLite and provides convenient access to things like
 // rate limit information.
 type Response struct {
 	*http.Response
+
+	Rate Rate
+
+	// FromCache is true if the response was served from the response cache.
+	FromCache bool
 }
 
 // newResponse creates a new Response for the provided http.Response.
 // r must not be nil.
 func newResponse(r *http.Response) *Response {
 	response := &Response{Response: r}
+	response.Rate = parseRate(r)
 
 	return response
 }
 
+// Rate represents the rate limit for the current client.
+type Rate struct {
+	// The number of requests per minute the client is currently limited to.
+	Limit int
+
+	// The number of remaining requests the client can make this minute.
+	Remaining int
+}
+
+// parseRate parses the rate related headers.
+func parseRate(r *http.Response) Rate {
+	var rate Rate
+
+	if limit := r.Header.Get(headerRateLimitLimit); limit != "" {
+		rate.Limit, _ = strconv.Atoi(limit)
+	}
+
+	if remaining := r.Header.Get(headerRateLimitRemaining); remaining != "" {
+		rate.Remaining, _ = strconv.Atoi(remaining)
+	}
+
+	return rate
+}
+
 // BareDo sends an API request and lets you handle the api response. If an error
 // or API Error occurs, the error will contain more information. Otherwise you
-// are supposed to read and close the response's Body. If rate limit is exceeded
-// and reset time is in the future, BareDo returns *RateLimitError immediately
-// without making a network API call.
+// are supposed to read and close the response's Body. Failed requests are
+// retried according to the MaxRetries option.
 func (c *Client) BareDo(req *http.Request) (*Response, error) {
-	// TODO: rate limit
+	o := callOptionsFromContext(req.Context())
+	if o.timeout <= 0 {
+		return c.observe(req, c.bareDo)
+	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		// If we got an error, and the context has been canceled,
-		// the context's error is probably more useful.
-		select {
-		case <-req.Context().Done():
-			return nil, req.Context().Err()
-		default:
-		}
+	ctx, cancel := context.WithTimeout(req.Context(), o.timeout)
 
-		return nil, err
+	resp, err := c.observe(req.WithContext(ctx), c.bareDo)
+	if err != nil || resp == nil {
+		cancel()
+
+		return resp, err
 	}
 
-	response := newResponse(resp)
+	// The timeout covers reading the body, so it can only be released once the body is closed.
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
 
-	err = CheckResponse(resp)
-	if err != nil {
-		defer resp.Body.Close()
+	return resp, nil
+}
+
+// cancelOnClose cancels a context when the body of a response is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	defer b.cancel()
+
+	return b.ReadCloser.Close()
+}
+
+// observe reports a call sent by send to the observers of the Client.
+func (c *Client) observe(req *http.Request, send func(req *http.Request) (*Response, int, error)) (*Response, error) {
+	call := Call{
+		Operation: operationFromContext(req.Context()),
+		Method:    req.Method,
+		URL:       c.redactURL(req.URL),
+	}
+
+	ctx := req.Context()
+	for _, observer := range c.observers {
+		ctx = observer.CallStarted(ctx, call)
+	}
+	req = req.WithContext(ctx)
+
+	start := time.Now()
+	response, retries, err := send(req)
+
+	if len(c.observers) > 0 {
+		result := CallResult{
+			Duration: time.Since(start),
+			Retries:  retries,
+			Err:      err,
+		}
+
+		if response != nil {
+			result.StatusCode = response.StatusCode
+			result.Rate = response.Rate
+			result.FromCache = response.FromCache
+		}
+
+		for i := len(c.observers) - 1; i >= 0; i-- {
+			c.observers[i].CallFinished(ctx, call, result)
+		}
 	}
 
 	return response, err
@@ -268,6 +478,8 @@ func (c *Client) BareDo(req *http.Request) (*Response, error) {
 // the 200 range.
 // API error responses are expected to have response
 // body, and a JSON response body that maps to ErrorResponse.
+// Bodies that are not JSON (eg. HTML pages of load balancers) are preserved
+// in ErrorResponse.Body.
 //
 // The error type will be *RateLimitError for rate limit exceeded errors,
 // *AcceptedError for 202 Accepted status codes,
@@ -277,42 +489,218 @@ func CheckResponse(r *http.Response) error {
 		return nil
 	}
 
-	errorResponse := &ErrorResponse{Response: r}
-	data, err := ioutil.ReadAll(r.Body)
+	errorResponse := &ErrorResponse{
+		Response:    r,
+		ContentType: r.Header.Get("Content-Type"),
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r.Body, maxErrorBodySize))
 	if err == nil && data != nil {
+		errorResponse.Body = data
+
 		// ignore error (we are already in an error scenario)
-		_ = json.Unmarshal(data, errorResponse)
+		_ = errorResponse.Err.decode(data)
 	}
 
 	return errorResponse
 }
 
+// maxErrorBodySize is the maximum number of bytes read from the body of error responses.
+const maxErrorBodySize = 64 << 10
+
 // ErrorResponse wraps the returned HTTP response and error.
 type ErrorResponse struct {
 	Response *http.Response // HTTP response that caused this error
 
 	Err Error `json:"error"`
+
+	// Body of the response (truncated to 64 KiB).
+	Body []byte `json:"-"`
+
+	// Content type of the response body.
+	ContentType string `json:"-"`
+
+	redact RedactionPolicy
+}
+
+// IsNotFound reports whether err is an API error caused by a missing resource.
+func IsNotFound(err error) bool {
+	var errorResponse *ErrorResponse
+
+	return errors.As(err, &errorResponse) && errorResponse.Response.StatusCode == http.StatusNotFound
 }
 
 func (r *ErrorResponse) Error() string {
-	return fmt.Sprintf("%v %v: %d %v %v",
+	return redactString(r.redact, r.Unredacted())
+}
+
+// Unredacted returns the error message without applying the redaction policy of the Client.
+func (r *ErrorResponse) Unredacted() string {
+	return fmt.Sprintf("%v %v: %d %v",
 		r.Response.Request.Method, r.Response.Request.URL,
-		r.Response.StatusCode, r.Err.Message, r.Err.Code,
+		r.Response.StatusCode, r.detail(),
 	)
 }
 
+// detail describes the error in the response body.
+func (r *ErrorResponse) detail() string {
+	if r.Err.Message != "" || len(r.Err.Fields) > 0 {
+		return r.Err.Error()
+	}
+
+	body := strings.TrimSpace(string(r.Body))
+	if body == "" {
+		return http.StatusText(r.Response.StatusCode)
+	}
+
+	mediaType := strings.TrimSpace(strings.Split(r.ContentType, ";")[0])
+
+	// A plain text body is probably a meaningful message, markup is not.
+	if mediaType == "" || mediaType == "text/plain" {
+		if i := strings.IndexAny(body, "\r\n"); i >= 0 {
+			body = body[:i]
+		}
+
+		if len(body) > 200 {
+			body = body[:200] + "..."
+		}
+
+		return body
+	}
+
+	return fmt.Sprintf("%s (%s response, %d bytes)", http.StatusText(r.Response.StatusCode), mediaType, len(r.Body))
+}
+
 // Error contains details about what went wrong during a failed API request.
 type Error struct {
 	Code    int    `json:"code"`    // Error code (optional)
 	Message string `json:"message"` // Human-readable error message
+
+	// Validation error messages keyed by the invalid field (optional).
+	Fields map[string][]string `json:"errors,omitempty"`
 }
 
 func (e Error) Error() string {
-	if e.Code == 0 {
-		return fmt.Sprintf("%s (code: %d)", e.Message, e.Code)
+	msg := e.Message
+	if e.Code != 0 {
+		msg = fmt.Sprintf("%s (code: %d)", msg, e.Code)
+	}
+
+	if len(e.Fields) == 0 {
+		return msg
+	}
+
+	fields := make([]string, 0, len(e.Fields))
+	for field := range e.Fields {
+		fields = append(fields, field)
 	}
 
-	return e.Message
+	sort.Strings(fields)
+
+	details := make([]string, 0, len(fields))
+	for _, field := range fields {
+		details = append(details, fmt.Sprintf("%s: %s", field, strings.Join(e.Fields[field], ", ")))
+	}
+
+	if msg == "" {
+		return strings.Join(details, "; ")
+	}
+
+	return fmt.Sprintf("%s [%s]", msg, strings.Join(details, "; "))
+}
+
+// decode decodes an error response body.
+// Both {"error": {"code": 123, "message": "..."}} and validation errors like
+// {"message": "...", "errors": {"field": ["..."]}} are supported.
+func (e *Error) decode(data []byte) error {
+	type body struct {
+		Error   json.RawMessage `json:"error"`
+		Code    WeakInt         `json:"code"`
+		Message WeakString      `json:"message"`
+		Errors  json.RawMessage `json:"errors"`
+	}
+
+	var outer body
+	if err := json.Unmarshal(data, &outer); err != nil {
+		return err
+	}
+
+	inner := outer
+
+	switch jsonKind(outer.Error) {
+	case jsonKindObject:
+		inner = body{}
+		if err := json.Unmarshal(outer.Error, &inner); err != nil {
+			return err
+		}
+
+		if inner.Message == "" {
+			inner.Message = outer.Message
+		}
+
+		if len(inner.Errors) == 0 {
+			inner.Errors = outer.Errors
+		}
+
+	case jsonKindString:
+		if err := json.Unmarshal(outer.Error, &inner.Message); err != nil {
+			return err
+		}
+	}
+
+	e.Code = int(inner.Code)
+	e.Message = string(inner.Message)
+	e.Fields = decodeFieldErrors(inner.Errors)
+
+	return nil
+}
+
+// decodeFieldErrors decodes validation error messages:
+// either an object of messages (or lists of messages) keyed by field,
+// or a list of objects with field and message keys.
+func decodeFieldErrors(data json.RawMessage) map[string][]string {
+	fields := make(map[string][]string)
+
+	switch jsonKind(data) {
+	case jsonKindObject:
+		var errs map[string]json.RawMessage
+		if json.Unmarshal(data, &errs) != nil {
+			return nil
+		}
+
+		for field, raw := range errs {
+			var messages []string
+			if json.Unmarshal(raw, &messages) == nil {
+				fields[field] = messages
+
+				continue
+			}
+
+			var message WeakString
+			if json.Unmarshal(raw, &message) == nil {
+				fields[field] = []string{string(message)}
+			}
+		}
+
+	case jsonKindArray:
+		var errs []struct {
+			Field   string `json:"field"`
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(data, &errs) != nil {
+			return nil
+		}
+
+		for _, err := range errs {
+			fields[err.Field] = append(fields[err.Field], err.Message)
+		}
+	}
+
+	if len(fields) == 0 {
+		return nil
+	}
+
+	return fields
 }
 
 // addOptions adds the parameters in opts as URL query parameters to s. opts
@@ -333,6 +721,12 @@ func addOptions(s string, opts interface{}) (string, error) {
 		return s, err
 	}
 
-	u.RawQuery = qs.Encode()
+	// Keep the query already present in s, options take precedence.
+	merged := u.Query()
+	for key, values := range qs {
+		merged[key] = values
+	}
+
+	u.RawQuery = merged.Encode()
 	return u.String(), nil
 }
@@ -0,0 +1,242 @@
+package mailerlite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// setup starts a test HTTP server and returns a Client talking to it.
+// Handlers are registered on the returned mux relative to the base URL (eg. "/groups").
+// Requests to the Connect API are sent to the same server.
+func setup(t *testing.T, opts ...ClientOption) (*Client, *http.ServeMux) {
+	t.Helper()
+
+	mux := http.NewServeMux()
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	baseURL, _ := url.Parse(server.URL + "/")
+
+	client, err := NewClient("api-key", append([]ClientOption{BaseURL(baseURL), ConnectBaseURL(baseURL)}, opts...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client, mux
+}
+
+func testMethod(t *testing.T, r *http.Request, want string) {
+	t.Helper()
+
+	if got := r.Method; got != want {
+		t.Errorf("request method: got %s, want %s", got, want)
+	}
+}
+
+func TestClient_BareDo_Timeout(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/stats", func(w http.ResponseWriter, r *http.Request) {
+		w.(http.Flusher).Flush()
+
+		<-r.Context().Done()
+	})
+
+	req, err := client.NewRequest(context.Background(), http.MethodGet, "stats", nil, WithTimeout(50*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := client.BareDo(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if _, err := io.ReadAll(resp.Body); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the body to time out, got %v", err)
+	}
+}
+
+func TestGet_MergesQuery(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/subscribers", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.RawQuery, "limit=5&type=active"; got != want {
+			t.Errorf("query: got %s, want %s", got, want)
+		}
+
+		_, _ = w.Write([]byte(`[]`))
+	})
+
+	_, _, err := Get[[]Subscriber](context.Background(), client, "subscribers?type=active", &ListOptions{Limit: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func errorHTTPResponse(statusCode int, contentType string, body string) *http.Response {
+	req, _ := http.NewRequest(http.MethodGet, "https://api.mailerlite.com/api/v2/groups/1", nil)
+
+	header := make(http.Header)
+	if contentType != "" {
+		header.Set("Content-Type", contentType)
+	}
+
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     header,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}
+}
+
+func TestCheckResponse(t *testing.T) {
+	tests := map[string]struct {
+		statusCode  int
+		contentType string
+		body        string
+		wantErr     Error
+		wantMessage string
+	}{
+		"nested error": {
+			statusCode:  http.StatusNotFound,
+			contentType: "application/json",
+			body:        `{"error":{"code":123,"message":"Group not found"}}`,
+			wantErr:     Error{Code: 123, Message: "Group not found"},
+			wantMessage: "GET https://api.mailerlite.com/api/v2/groups/1: 404 Group not found (code: 123)",
+		},
+		"string error": {
+			statusCode:  http.StatusBadRequest,
+			contentType: "application/json",
+			body:        `{"error":"Bad request"}`,
+			wantErr:     Error{Message: "Bad request"},
+			wantMessage: "GET https://api.mailerlite.com/api/v2/groups/1: 400 Bad request",
+		},
+		"validation errors map": {
+			statusCode:  http.StatusUnprocessableEntity,
+			contentType: "application/json",
+			body:        `{"message":"The given data was invalid.","errors":{"email":["The email is invalid."],"name":"The name is too long."}}`,
+			wantErr: Error{
+				Message: "The given data was invalid.",
+				Fields: map[string][]string{
+					"email": {"The email is invalid."},
+					"name":  {"The name is too long."},
+				},
+			},
+			wantMessage: "GET https://api.mailerlite.com/api/v2/groups/1: 422 The given data was invalid. [email: The email is invalid.; name: The name is too long.]",
+		},
+		"validation errors list": {
+			statusCode:  http.StatusUnprocessableEntity,
+			contentType: "application/json",
+			body:        `{"error":{"code":400,"message":"Invalid data"},"errors":[{"field":"email","message":"The email is invalid."},{"field":"email","message":"The email is taken."}]}`,
+			wantErr: Error{
+				Code:    400,
+				Message: "Invalid data",
+				Fields:  map[string][]string{"email": {"The email is invalid.", "The email is taken."}},
+			},
+			wantMessage: "GET https://api.mailerlite.com/api/v2/groups/1: 422 Invalid data (code: 400) [email: The email is invalid., The email is taken.]",
+		},
+		"plain text": {
+			statusCode:  http.StatusBadGateway,
+			contentType: "text/plain; charset=utf-8",
+			body:        "upstream connect error\nreset reason: overflow",
+			wantMessage: "GET https://api.mailerlite.com/api/v2/groups/1: 502 upstream connect error",
+		},
+		"html": {
+			statusCode:  http.StatusServiceUnavailable,
+			contentType: "text/html",
+			body:        "<html><body><h1>503 Service Unavailable</h1></body></html>",
+			wantMessage: "GET https://api.mailerlite.com/api/v2/groups/1: 503 Service Unavailable (text/html response, 58 bytes)",
+		},
+		"empty": {
+			statusCode:  http.StatusInternalServerError,
+			wantMessage: "GET https://api.mailerlite.com/api/v2/groups/1: 500 Internal Server Error",
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			err := CheckResponse(errorHTTPResponse(test.statusCode, test.contentType, test.body))
+
+			var errorResponse *ErrorResponse
+			if !errors.As(err, &errorResponse) {
+				t.Fatalf("expected *ErrorResponse, got %v", err)
+			}
+
+			if !reflect.DeepEqual(errorResponse.Err, test.wantErr) {
+				t.Errorf("error: got %+v, want %+v", errorResponse.Err, test.wantErr)
+			}
+
+			if got := err.Error(); got != test.wantMessage {
+				t.Errorf("message:\ngot  %q\nwant %q", got, test.wantMessage)
+			}
+
+			if got := string(errorResponse.Body); got != test.body {
+				t.Errorf("body: got %q, want %q", got, test.body)
+			}
+		})
+	}
+}
+
+func TestCheckResponse_BodyLimit(t *testing.T) {
+	body := strings.Repeat("a", 2*maxErrorBodySize)
+
+	err := CheckResponse(errorHTTPResponse(http.StatusInternalServerError, "text/plain", body))
+
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) {
+		t.Fatalf("expected *ErrorResponse, got %v", err)
+	}
+
+	if got := len(errorResponse.Body); got != 64<<10 {
+		t.Errorf("body length: got %d, want %d", got, 64<<10)
+	}
+
+	if got := err.Error(); !strings.HasSuffix(got, strings.Repeat("a", 200)+"...") || len(got) > 300 {
+		t.Errorf("expected a truncated message, got %d bytes", len(got))
+	}
+}
+
+func TestCheckResponse_Success(t *testing.T) {
+	if err := CheckResponse(errorHTTPResponse(http.StatusNoContent, "", "")); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	notFound := CheckResponse(errorHTTPResponse(http.StatusNotFound, "application/json", `{"error":{"code":123,"message":"Group not found"}}`))
+	badRequest := CheckResponse(errorHTTPResponse(http.StatusBadRequest, "application/json", `{"error":{"code":1,"message":"Bad request"}}`))
+
+	if !IsNotFound(notFound) {
+		t.Error("expected a 404 response to be not found")
+	}
+
+	if !IsNotFound(fmt.Errorf("loading group: %w", notFound)) {
+		t.Error("expected a wrapped 404 response to be not found")
+	}
+
+	if IsNotFound(badRequest) {
+		t.Error("expected a 400 response not to be not found")
+	}
+
+	if IsNotFound(errors.New("not found")) {
+		t.Error("expected a plain error not to be not found")
+	}
+
+	if IsNotFound(nil) {
+		t.Error("expected nil not to be not found")
+	}
+}
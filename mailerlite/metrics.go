@@ -0,0 +1,261 @@
+package mailerlite
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// MetricsCollector records metrics about API calls.
+type MetricsCollector interface {
+	// ObserveLatency records the duration of an API call.
+	ObserveLatency(operation string, method string, duration time.Duration)
+
+	// CountStatus counts a response status code (zero if no response was received).
+	CountStatus(operation string, statusCode int)
+
+	// CountRetries records the number of times an API call was retried.
+	CountRetries(operation string, retries int)
+
+	// SetRateLimitRemaining records the number of requests remaining in the current rate limit window.
+	SetRateLimitRemaining(remaining int)
+}
+
+// CacheHitCounter can be implemented by a MetricsCollector to count responses served from the response cache.
+// Cache hits are not recorded as latencies or status codes, since no request is sent to the API.
+type CacheHitCounter interface {
+	CountCacheHit(operation string)
+}
+
+// NewMetricsObserver returns an Observer that records API call metrics in a MetricsCollector.
+func NewMetricsObserver(collector MetricsCollector) Observer {
+	return metricsObserver{collector: collector}
+}
+
+type metricsObserver struct {
+	collector MetricsCollector
+}
+
+func (o metricsObserver) CallStarted(ctx context.Context, _ Call) context.Context {
+	return ctx
+}
+
+func (o metricsObserver) CallFinished(_ context.Context, call Call, result CallResult) {
+	if result.FromCache {
+		if counter, ok := o.collector.(CacheHitCounter); ok {
+			counter.CountCacheHit(call.Operation)
+		}
+
+		return
+	}
+
+	o.collector.ObserveLatency(call.Operation, call.Method, result.Duration)
+	o.collector.CountStatus(call.Operation, result.StatusCode)
+	o.collector.CountRetries(call.Operation, result.Retries)
+
+	if result.Rate.Limit > 0 {
+		o.collector.SetRateLimitRemaining(result.Rate.Remaining)
+	}
+}
+
+// ValueObserver observes values in a histogram or summary (eg. prometheus.Observer).
+type ValueObserver interface {
+	Observe(value float64)
+}
+
+// ValueAdder adds values to a counter (eg. prometheus.Counter).
+type ValueAdder interface {
+	Add(value float64)
+}
+
+// ValueSetter sets the value of a gauge (eg. prometheus.Gauge).
+type ValueSetter interface {
+	Set(value float64)
+}
+
+// PrometheusMetrics is a MetricsCollector backed by Prometheus-style metric vectors.
+//
+// Every field is optional. Vector fields receive label values in the documented order
+// and are usually implemented by calling WithLabelValues on the corresponding vector:
+//
+//	mailerlite.PrometheusMetrics{
+//		Latency: func(lvs ...string) mailerlite.ValueObserver { return latencyVec.WithLabelValues(lvs...) },
+//	}
+type PrometheusMetrics struct {
+	// Latency receives the call duration in seconds.
+	// Labels: operation, method
+	Latency func(labelValues ...string) ValueObserver
+
+	// Status counts responses.
+	// Labels: operation, code
+	Status func(labelValues ...string) ValueAdder
+
+	// Retries counts retried requests.
+	// Labels: operation
+	Retries func(labelValues ...string) ValueAdder
+
+	// RateLimitRemaining records the number of requests remaining in the current rate limit window.
+	RateLimitRemaining ValueSetter
+
+	// CacheHits counts responses served from the response cache.
+	// Labels: operation
+	CacheHits func(labelValues ...string) ValueAdder
+}
+
+// ObserveLatency implements the MetricsCollector interface.
+func (m PrometheusMetrics) ObserveLatency(operation string, method string, duration time.Duration) {
+	if m.Latency == nil {
+		return
+	}
+
+	m.Latency(operation, method).Observe(duration.Seconds())
+}
+
+// CountStatus implements the MetricsCollector interface.
+func (m PrometheusMetrics) CountStatus(operation string, statusCode int) {
+	if m.Status == nil {
+		return
+	}
+
+	m.Status(operation, strconv.Itoa(statusCode)).Add(1)
+}
+
+// CountRetries implements the MetricsCollector interface.
+func (m PrometheusMetrics) CountRetries(operation string, retries int) {
+	if m.Retries == nil || retries == 0 {
+		return
+	}
+
+	m.Retries(operation).Add(float64(retries))
+}
+
+// SetRateLimitRemaining implements the MetricsCollector interface.
+func (m PrometheusMetrics) SetRateLimitRemaining(remaining int) {
+	if m.RateLimitRemaining == nil {
+		return
+	}
+
+	m.RateLimitRemaining.Set(float64(remaining))
+}
+
+// CountCacheHit implements the CacheHitCounter interface.
+func (m PrometheusMetrics) CountCacheHit(operation string) {
+	if m.CacheHits == nil {
+		return
+	}
+
+	m.CacheHits(operation).Add(1)
+}
+
+// MemoryMetrics is a MetricsCollector that keeps metrics in memory.
+// It is mostly useful in tests.
+type MemoryMetrics struct {
+	mu sync.Mutex
+
+	latencies          map[string][]time.Duration
+	statuses           map[string]map[int]int
+	retries            map[string]int
+	cacheHits          map[string]int
+	rateLimitRemaining int
+}
+
+// NewMemoryMetrics returns a new MemoryMetrics.
+func NewMemoryMetrics() *MemoryMetrics {
+	return &MemoryMetrics{
+		latencies:          make(map[string][]time.Duration),
+		statuses:           make(map[string]map[int]int),
+		retries:            make(map[string]int),
+		cacheHits:          make(map[string]int),
+		rateLimitRemaining: -1,
+	}
+}
+
+// ObserveLatency implements the MetricsCollector interface.
+func (m *MemoryMetrics) ObserveLatency(operation string, _ string, duration time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.latencies[operation] = append(m.latencies[operation], duration)
+}
+
+// CountStatus implements the MetricsCollector interface.
+func (m *MemoryMetrics) CountStatus(operation string, statusCode int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.statuses[operation] == nil {
+		m.statuses[operation] = make(map[int]int)
+	}
+
+	m.statuses[operation][statusCode]++
+}
+
+// CountRetries implements the MetricsCollector interface.
+func (m *MemoryMetrics) CountRetries(operation string, retries int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.retries[operation] += retries
+}
+
+// SetRateLimitRemaining implements the MetricsCollector interface.
+func (m *MemoryMetrics) SetRateLimitRemaining(remaining int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.rateLimitRemaining = remaining
+}
+
+// CountCacheHit implements the CacheHitCounter interface.
+func (m *MemoryMetrics) CountCacheHit(operation string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.cacheHits[operation]++
+}
+
+// Latencies returns the recorded call durations of an operation.
+func (m *MemoryMetrics) Latencies(operation string) []time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]time.Duration(nil), m.latencies[operation]...)
+}
+
+// Statuses returns the number of responses per status code of an operation.
+func (m *MemoryMetrics) Statuses(operation string) map[int]int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	statuses := make(map[int]int, len(m.statuses[operation]))
+	for code, count := range m.statuses[operation] {
+		statuses[code] = count
+	}
+
+	return statuses
+}
+
+// Retries returns the number of retries of an operation.
+func (m *MemoryMetrics) Retries(operation string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.retries[operation]
+}
+
+// RateLimitRemaining returns the last recorded rate limit headroom (or -1 if none was recorded).
+func (m *MemoryMetrics) RateLimitRemaining() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.rateLimitRemaining
+}
+
+// CacheHits returns the number of responses of an operation served from the response cache.
+func (m *MemoryMetrics) CacheHits(operation string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.cacheHits[operation]
+}
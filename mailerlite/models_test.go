@@ -0,0 +1,354 @@
+package mailerlite
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// TestModels_RoundTrip decodes the canonical JSON form of every model,
+// and checks that encoding it again results in the same JSON.
+func TestModels_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		model  func() interface{}
+		golden string
+	}{
+		{
+			name:  "Subscriber",
+			model: func() interface{} { return &Subscriber{} },
+			golden: `{
+				"id": 1,
+				"name": "John",
+				"email": "john@example.com",
+				"sent": 10,
+				"opened": 5,
+				"clicked": 2,
+				"type": "active",
+				"country_id": "HU",
+				"signup_ip": "127.0.0.1",
+				"signup_timestamp": "2022-01-01 10:00:00",
+				"confirmation_ip": null,
+				"confirmation_timestamp": null,
+				"fields": [
+					{"key": "company", "value": "Acme", "type": "TEXT"},
+					{"key": "age", "value": 42, "type": "NUMBER"},
+					{"key": "birthday", "value": "1980-01-02", "type": "DATE"},
+					{"key": "city", "value": null, "type": "TEXT"}
+				],
+				"date_subscribe": "2022-01-01 10:00:00",
+				"date_unsubscribe": null,
+				"date_created": "2022-01-01 10:00:00",
+				"date_updated": "2022-01-02 10:00:00"
+			}`,
+		},
+		{
+			name:  "Group",
+			model: func() interface{} { return &Group{} },
+			golden: `{
+				"id": 1,
+				"name": "Customers",
+				"total": 10,
+				"active": 6,
+				"unsubscribed": 1,
+				"bounced": 1,
+				"unconfirmed": 1,
+				"junk": 1,
+				"sent": 100,
+				"opened": 50,
+				"clicked": 10,
+				"date_created": "2022-01-01 10:00:00",
+				"date_updated": null
+			}`,
+		},
+		{
+			name:  "Field",
+			model: func() interface{} { return &Field{} },
+			golden: `{
+				"id": 1,
+				"title": "Company",
+				"key": "company",
+				"type": "TEXT",
+				"date_updated": "2022-01-02 10:00:00",
+				"date_created": "2022-01-01 10:00:00"
+			}`,
+		},
+		{
+			name:  "Campaign",
+			model: func() interface{} { return &Campaign{} },
+			golden: `{
+				"id": 1,
+				"name": "Newsletter",
+				"type": "regular",
+				"status": "sent",
+				"total_recipients": 100,
+				"opened": {"count": 50, "rate": 50.5},
+				"clicked": {"count": 10, "rate": 10},
+				"date_created": "2022-01-01 10:00:00",
+				"date_send": "2022-01-02 10:00:00"
+			}`,
+		},
+		{
+			name:  "Stats",
+			model: func() interface{} { return &Stats{} },
+			golden: `{
+				"subscribed": 100,
+				"unsubscribed": 10,
+				"campaigns": 5,
+				"sent_emails": 500,
+				"open_rate": 0.5,
+				"click_rate": 0.1,
+				"bounce_rate": 0.01
+			}`,
+		},
+		{
+			name:  "Webhook",
+			model: func() interface{} { return &Webhook{} },
+			golden: `{
+				"id": 1,
+				"event": "subscriber.create",
+				"url": "https://example.com/webhook",
+				"date_created": "2022-01-01 10:00:00",
+				"date_updated": null
+			}`,
+		},
+		{
+			name:  "Automation",
+			model: func() interface{} { return &Automation{} },
+			golden: `{
+				"id": 1,
+				"name": "Onboarding",
+				"enabled": true,
+				"triggers": [{"id": 2, "type": "subscriber_joins_group", "group_id": 3, "broken": false}],
+				"steps": [
+					{
+						"id": 4,
+						"type": "email",
+						"name": "Welcome",
+						"subject": "Welcome!",
+						"unit": "",
+						"value": "",
+						"description": "",
+						"stats": {
+							"sent": 12,
+							"opens_count": 8,
+							"unique_opens_count": 6,
+							"clicks_count": 4,
+							"unique_clicks_count": 3,
+							"unsubscribes_count": 1,
+							"spam_count": 0,
+							"hard_bounces_count": 0,
+							"soft_bounces_count": 1,
+							"open_rate": {"float": 0.5, "string": "50%"},
+							"click_rate": {"float": 0.25, "string": "25%"},
+							"click_to_open_rate": {"float": 0.5, "string": "50%"},
+							"unsubscribe_rate": {"float": 0.08, "string": "8%"}
+						}
+					},
+					{"id": 5, "type": "delay", "name": "", "subject": "", "unit": "days", "value": "1", "description": "1 day", "stats": null}
+				],
+				"complete": true,
+				"broken": false,
+				"emails_count": 1,
+				"stats": {
+					"completed_subscribers_count": 10,
+					"subscribers_in_queue_count": 2,
+					"sent": 12,
+					"opens_count": 8,
+					"unique_opens_count": 6,
+					"clicks_count": 4,
+					"unique_clicks_count": 3,
+					"unsubscribes_count": 1,
+					"spam_count": 0,
+					"hard_bounces_count": 0,
+					"soft_bounces_count": 1,
+					"open_rate": {"float": 0.5, "string": "50%"},
+					"click_rate": {"float": 0.25, "string": "25%"},
+					"click_to_open_rate": {"float": 0.5, "string": "50%"},
+					"unsubscribe_rate": {"float": 0.08, "string": "8%"},
+					"spam_rate": {"float": 0, "string": "0%"},
+					"bounce_rate": {"float": 0.08, "string": "8%"},
+					"hard_bounce_rate": {"float": 0, "string": "0%"},
+					"soft_bounce_rate": {"float": 0.08, "string": "8%"}
+				},
+				"created_at": "2022-01-01 10:00:00"
+			}`,
+		},
+		{
+			name:  "AutomationActivity",
+			model: func() interface{} { return &AutomationActivity{} },
+			golden: `{
+				"id": 1,
+				"status": "completed",
+				"date": "2022-01-01 10:00:00",
+				"reason": "",
+				"reason_description": "",
+				"subscriber": {"id": 2, "email": "john@example.com"}
+			}`,
+		},
+		{
+			name:  "CampaignReport",
+			model: func() interface{} { return &CampaignReport{} },
+			golden: `{
+				"id": 1,
+				"name": "Newsletter",
+				"stats": {
+					"sent": 100,
+					"opens_count": 60,
+					"unique_opens_count": 50,
+					"clicks_count": 12,
+					"unique_clicks_count": 10,
+					"unsubscribes_count": 1,
+					"spam_count": 0,
+					"hard_bounces_count": 1,
+					"soft_bounces_count": 2,
+					"forwards_count": 0,
+					"open_rate": {"float": 0.5, "string": "50%"},
+					"click_rate": {"float": 0.1, "string": "10%"},
+					"click_to_open_rate": {"float": 0.2, "string": "20%"},
+					"unsubscribe_rate": {"float": 0.01, "string": "1%"},
+					"spam_rate": {"float": 0, "string": "0%"},
+					"hard_bounce_rate": {"float": 0.01, "string": "1%"},
+					"soft_bounce_rate": {"float": 0.02, "string": "2%"}
+				}
+			}`,
+		},
+		{
+			name:  "CampaignActivity",
+			model: func() interface{} { return &CampaignActivity{} },
+			golden: `{
+				"id": 1,
+				"opens_count": 2,
+				"clicks_count": 1,
+				"subscriber": {"id": 2, "email": "john@example.com"},
+				"clicks": [{"url": "https://example.com", "clicks_count": 1}]
+			}`,
+		},
+		{
+			name:  "Form",
+			model: func() interface{} { return &Form{} },
+			golden: `{
+				"id": 1,
+				"type": "popup",
+				"slug": "newsletter",
+				"name": "Newsletter",
+				"active": true,
+				"opens_count": 100,
+				"conversions_count": 5,
+				"conversion_rate": {"float": 0.05, "string": "5%"},
+				"double_optin": false,
+				"last_registration_at": "2022-01-02 10:00:00",
+				"created_at": "2022-01-01 10:00:00",
+				"groups": [{"id": 2, "name": "Customers"}]
+			}`,
+		},
+		{
+			name:  "FormSubscriber",
+			model: func() interface{} { return &FormSubscriber{} },
+			golden: `{
+				"id": 1,
+				"email": "john@example.com",
+				"status": "active",
+				"source": "webform",
+				"fields": {"city": "Budapest", "age": 42},
+				"subscribed_at": "2022-01-01 10:00:00",
+				"created_at": "2022-01-01 10:00:00"
+			}`,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			decoded := test.model()
+			if err := json.Unmarshal([]byte(test.golden), decoded); err != nil {
+				t.Fatal(err)
+			}
+
+			encoded, err := json.Marshal(decoded)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got, want := canonicalJSON(t, encoded), canonicalJSON(t, []byte(test.golden)); got != want {
+				t.Errorf("round trip changed the JSON:\ngot:  %s\nwant: %s", got, want)
+			}
+
+			again := test.model()
+			if err := json.Unmarshal(encoded, again); err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(decoded, again) {
+				t.Errorf("round trip changed the model:\ngot:  %+v\nwant: %+v", again, decoded)
+			}
+		})
+	}
+}
+
+// canonicalJSON formats a JSON document with sorted object keys.
+func canonicalJSON(t *testing.T, data []byte) string {
+	t.Helper()
+
+	var v interface{}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	if err := dec.Decode(&v); err != nil {
+		t.Fatal(err)
+	}
+
+	canonical, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(canonical)
+}
+
+func TestField_WeakTypes(t *testing.T) {
+	var field Field
+	if err := json.Unmarshal([]byte(`{"id": "1", "title": 2024, "key": "2024", "type": "NUMBER"}`), &field); err != nil {
+		t.Fatal(err)
+	}
+
+	if field.ID != 1 || field.Title != "2024" || field.Key != "2024" {
+		t.Errorf("unexpected field: %+v", field)
+	}
+}
+
+func TestForm_WeakTypes(t *testing.T) {
+	for _, data := range []string{
+		`{"active": true, "double_optin": false}`,
+		`{"active": 1, "double_optin": 0}`,
+		`{"active": "1", "double_optin": "0"}`,
+		`{"active": "true", "double_optin": ""}`,
+	} {
+		var form Form
+		if err := json.Unmarshal([]byte(data), &form); err != nil {
+			t.Fatalf("%s: %v", data, err)
+		}
+
+		if !form.Active || form.DoubleOptin {
+			t.Errorf("%s: unexpected form: %+v", data, form)
+		}
+	}
+}
+
+func TestSubscriber_NullTypes(t *testing.T) {
+	var subscriber Subscriber
+	if err := json.Unmarshal([]byte(`{"signup_ip": "127.0.0.1", "confirmation_ip": null}`), &subscriber); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := NewNull("127.0.0.1"); subscriber.SignupIP != want {
+		t.Errorf("signup ip: got %+v, want %+v", subscriber.SignupIP, want)
+	}
+
+	if subscriber.ConfirmationIP.Valid || subscriber.ConfirmationIP.Ptr() != nil {
+		t.Errorf("confirmation ip should be null, got %+v", subscriber.ConfirmationIP)
+	}
+}
@@ -0,0 +1,87 @@
+package mailerlite
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// Observer receives instrumentation events about the API calls made by a Client.
+//
+// Observers are invoked synchronously, so implementations should return quickly.
+type Observer interface {
+	// CallStarted is called before the first attempt of an API call is sent
+	// (or before a cached response is served).
+	// The returned context is used for sending the request,
+	// making it possible to propagate spans and other request scoped values.
+	CallStarted(ctx context.Context, call Call) context.Context
+
+	// CallFinished is called after an API call completed, either successfully or with an error.
+	// ctx is the context returned by CallStarted.
+	CallFinished(ctx context.Context, call Call, result CallResult)
+}
+
+// Call describes an API call sent by a Client.
+type Call struct {
+	// Operation is the name of the service method that sent the call (eg. GroupsService.AddSubscriber).
+	Operation string
+
+	// Method is the HTTP method of the request.
+	Method string
+
+	// URL is the URL the request is sent to.
+	URL *url.URL
+}
+
+// CallResult describes the outcome of an API call.
+type CallResult struct {
+	// StatusCode is the HTTP status code of the last response.
+	// It is zero if no response was received.
+	StatusCode int
+
+	// Duration is the time it took to complete the call, including retries.
+	Duration time.Duration
+
+	// Retries is the number of times the request was retried.
+	Retries int
+
+	// Rate is the rate limit information returned with the last response.
+	Rate Rate
+
+	// Err is the error returned by the call (if any).
+	Err error
+
+	// FromCache is true if the response was served from the response cache
+	// without sending a request (see ResponseCache).
+	FromCache bool
+}
+
+// Instrument configures a Client to report API calls to the given observers.
+func Instrument(observers ...Observer) ClientOption {
+	return clientOptionFunc(func(c *Client) {
+		for _, observer := range observers {
+			if observer == nil {
+				continue
+			}
+
+			c.observers = append(c.observers, observer)
+		}
+	})
+}
+
+type operationContextKey struct{}
+
+// withOperation records the name of the service method sending a request in the context.
+func withOperation(ctx context.Context, operation string) context.Context {
+	return context.WithValue(ctx, operationContextKey{}, operation)
+}
+
+// operationFromContext returns the name of the service method stored in the context.
+// Requests not sent by a service method are reported as Client.Do.
+func operationFromContext(ctx context.Context) string {
+	if operation, ok := ctx.Value(operationContextKey{}).(string); ok && operation != "" {
+		return operation
+	}
+
+	return "Client.Do"
+}
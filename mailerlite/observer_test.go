@@ -0,0 +1,128 @@
+package mailerlite
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestInstrument(t *testing.T) {
+	metrics := NewMemoryMetrics()
+	tracer := NewMemoryTracer()
+
+	client, mux := setup(t, MaxRetries(1), Instrument(NewMetricsObserver(metrics), NewTracingObserver(tracer)))
+
+	var attempts int
+	mux.HandleFunc("/groups/1/subscribers", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+
+		w.Header().Set("X-RateLimit-Limit", "60")
+		w.Header().Set("X-RateLimit-Remaining", "58")
+
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id":1,"email":"john@example.com"}`))
+	})
+
+	_, _, err := client.Groups.AddSubscriber(context.Background(), 1, NewSubscriberInGroup{Email: "john@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const operation = "GroupsService.AddSubscriber"
+
+	if got := len(metrics.Latencies(operation)); got != 1 {
+		t.Errorf("expected 1 latency observation, got %d", got)
+	}
+
+	if got, want := metrics.Statuses(operation), map[int]int{http.StatusOK: 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("statuses: got %v, want %v", got, want)
+	}
+
+	if got := metrics.Retries(operation); got != 1 {
+		t.Errorf("retries: got %d, want 1", got)
+	}
+
+	if got := metrics.RateLimitRemaining(); got != 58 {
+		t.Errorf("rate limit remaining: got %d, want 58", got)
+	}
+
+	spans := tracer.Spans()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+
+	span := spans[0]
+
+	if span.Name != operation {
+		t.Errorf("span name: got %q, want %q", span.Name, operation)
+	}
+
+	wantAttributes := map[string]interface{}{
+		"http.method":                     http.MethodPost,
+		"http.url":                        client.baseURL.String() + "groups/1/subscribers",
+		"http.status_code":                http.StatusOK,
+		"mailerlite.retries":              1,
+		"mailerlite.rate_limit.remaining": 58,
+	}
+	if !reflect.DeepEqual(span.Attributes, wantAttributes) {
+		t.Errorf("span attributes: got %v, want %v", span.Attributes, wantAttributes)
+	}
+
+	if span.Err != nil {
+		t.Errorf("unexpected span error: %v", span.Err)
+	}
+
+	if span.EndTime.Before(span.StartTime) {
+		t.Error("span ended before it started")
+	}
+}
+
+func TestInstrument_Error(t *testing.T) {
+	metrics := NewMemoryMetrics()
+	tracer := NewMemoryTracer()
+
+	client, mux := setup(t, Instrument(NewMetricsObserver(metrics), NewTracingObserver(tracer)))
+
+	mux.HandleFunc("/groups/1/subscribers", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":{"code":123,"message":"Group not found"}}`))
+	})
+
+	_, _, err := client.Groups.Subscribers(context.Background(), 1, nil)
+
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) {
+		t.Fatalf("expected *ErrorResponse, got %v", err)
+	}
+
+	const operation = "GroupsService.Subscribers"
+
+	if got, want := metrics.Statuses(operation), map[int]int{http.StatusNotFound: 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("statuses: got %v, want %v", got, want)
+	}
+
+	if got := metrics.RateLimitRemaining(); got != -1 {
+		t.Errorf("rate limit remaining should not be set without rate limit headers, got %d", got)
+	}
+
+	spans := tracer.Spans()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+
+	if spans[0].Err != err { // nolint: errorlint
+		t.Errorf("span error: got %v, want %v", spans[0].Err, err)
+	}
+
+	if got := spans[0].Attributes["http.status_code"]; got != http.StatusNotFound {
+		t.Errorf("span status code: got %v, want %d", got, http.StatusNotFound)
+	}
+}
@@ -0,0 +1,134 @@
+package mailerlite
+
+import (
+	"net/url"
+	"regexp"
+)
+
+// RedactionPolicy masks personal data (eg. email addresses) in a string.
+type RedactionPolicy func(s string) string
+
+// emailPattern matches email addresses, including percent-encoded ones in URLs.
+var emailPattern = regexp.MustCompile(`([A-Za-z0-9._%+\-])[A-Za-z0-9._%+\-]*(@|%40)([A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)+)`)
+
+// RedactEmails masks the local part of email addresses except for its first character
+// (eg. john@example.com becomes j***@example.com).
+func RedactEmails(s string) string {
+	return emailPattern.ReplaceAllString(s, "${1}***${2}${3}")
+}
+
+// RedactPII configures a Client to mask personal data in the messages of returned errors
+// and in the request URLs passed to observers (and therefore to metrics and traces).
+// The full messages remain available through the Unredacted method of the errors.
+// Transport errors (*url.Error) wrap a *RedactedError, whose Unredacted method returns the full message:
+//
+//	var redacted *mailerlite.RedactedError
+//	if errors.As(err, &redacted) {
+//		log.Print(redacted.Unredacted())
+//	}
+//
+// A nil policy defaults to RedactEmails.
+// Custom policies can mask other identifiers as well.
+func RedactPII(policy RedactionPolicy) ClientOption {
+	return clientOptionFunc(func(c *Client) {
+		if policy == nil {
+			policy = RedactEmails
+		}
+
+		c.redact = policy
+	})
+}
+
+// Redact applies the redaction policy of the Client to s.
+// Without a policy s is returned as is.
+func (c *Client) Redact(s string) string {
+	if c.redact == nil {
+		return s
+	}
+
+	return c.redact(s)
+}
+
+// redactURL returns a copy of u with the redaction policy of the Client applied to its path and query.
+func (c *Client) redactURL(u *url.URL) *url.URL {
+	if c.redact == nil || u == nil {
+		return u
+	}
+
+	redacted := *u
+	redacted.Path = c.redact(u.Path)
+	redacted.RawPath = c.redact(u.RawPath)
+	redacted.RawQuery = c.redact(u.RawQuery)
+
+	return &redacted
+}
+
+// redactError attaches the redaction policy of the Client to errors of this package.
+func (c *Client) redactError(err error) error {
+	if c.redact == nil {
+		return err
+	}
+
+	switch err := err.(type) { // nolint: errorlint
+	case *ErrorResponse:
+		err.redact = c.redact
+
+	case *EmailValidationError:
+		err.redact = c.redact
+
+	case *SuppressedError:
+		err.redact = c.redact
+
+	case *SuppressionRemovalError:
+		err.redact = c.redact
+
+	case *url.Error:
+		// Transport errors include the request URL (and often repeat it in the wrapped error).
+		return &url.Error{
+			Op:  err.Op,
+			URL: c.redact(err.URL),
+			Err: &RedactedError{err: err.Err, original: err, redact: c.redact},
+		}
+	}
+
+	return err
+}
+
+// RedactedError is wrapped by the transport errors (*url.Error) returned by a Client configured with RedactPII.
+// It applies the redaction policy to the message of the underlying error, while the URL of the *url.Error is redacted directly.
+type RedactedError struct {
+	err      error
+	original *url.Error
+	redact   RedactionPolicy
+}
+
+func (e *RedactedError) Error() string {
+	return e.redact(e.err.Error())
+}
+
+// Unredacted returns the full message of the transport error (including the request URL)
+// without applying the redaction policy of the Client.
+func (e *RedactedError) Unredacted() string {
+	return e.original.Error()
+}
+
+// Unwrap returns the underlying error (eg. context.DeadlineExceeded).
+func (e *RedactedError) Unwrap() error {
+	return e.err
+}
+
+// Timeout reports whether the underlying error is a timeout, so url.Error.Timeout keeps working.
+func (e *RedactedError) Timeout() bool {
+	t, ok := e.err.(interface{ Timeout() bool }) // nolint: errorlint
+
+	return ok && t.Timeout()
+}
+
+// redactString applies policy to s if it is not nil.
+func redactString(policy RedactionPolicy, s string) string {
+	if policy == nil {
+		return s
+	}
+
+	return policy(s)
+}
@@ -0,0 +1,96 @@
+package mailerlite
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestRedactPII_TransportError(t *testing.T) {
+	server := httptest.NewServer(nil)
+	server.Close() // requests fail to connect
+
+	baseURL, _ := url.Parse(server.URL + "/")
+
+	client, err := NewClient("api-key", BaseURL(baseURL), RedactPII(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err = client.Subscribers.Get(context.Background(), "john@example.com")
+	if err == nil {
+		t.Fatal("expected a dial error")
+	}
+
+	if msg := err.Error(); strings.Contains(msg, "john@example.com") || strings.Contains(msg, "john%40example.com") {
+		t.Errorf("error leaks the email address: %s", msg)
+	}
+
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		t.Errorf("expected a *url.Error, got %T", err)
+	}
+}
+
+func TestRedactedError(t *testing.T) {
+	server := httptest.NewServer(nil)
+	server.Close() // requests fail to connect
+
+	baseURL, _ := url.Parse(server.URL + "/")
+
+	client, err := NewClient("api-key", BaseURL(baseURL), RedactPII(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, _, err = client.Subscribers.Get(context.Background(), "john@example.com")
+
+	var redacted *RedactedError
+	if !errors.As(err, &redacted) {
+		t.Fatalf("expected a *RedactedError, got %T", err)
+	}
+
+	if msg := redacted.Unredacted(); !strings.Contains(msg, "john@example.com") {
+		t.Errorf("unredacted message should contain the request URL: %s", msg)
+	}
+
+	if msg := redacted.Error(); strings.Contains(msg, "john%40example.com") || strings.Contains(msg, "john@example.com") {
+		t.Errorf("redacted message leaks the email address: %s", msg)
+	}
+
+	var opErr *net.OpError
+	if !errors.As(err, &opErr) {
+		t.Errorf("expected the underlying *net.OpError to be reachable, got %T", errors.Unwrap(redacted))
+	}
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string { return "i/o timeout" }
+func (timeoutError) Timeout() bool { return true }
+
+func TestRedactedError_Timeout(t *testing.T) {
+	client, err := NewClient("api-key", RedactPII(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = client.redactError(&url.Error{Op: "Get", URL: "https://example.com/subscribers/john@example.com", Err: timeoutError{}})
+
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		t.Fatalf("expected a *url.Error, got %T", err)
+	}
+
+	if !urlErr.Timeout() {
+		t.Error("expected the redacted transport error to be a timeout")
+	}
+
+	if !errors.Is(err, timeoutError{}) {
+		t.Errorf("expected the error to wrap the timeout error, got %v", err)
+	}
+}
@@ -0,0 +1,145 @@
+package mailerlite
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	headerRetryAfter = "Retry-After"
+
+	// Base delay between retries when the API does not tell how long to wait.
+	retryBaseDelay = 500 * time.Millisecond
+	retryMaxDelay  = 30 * time.Second
+)
+
+// MaxRetries configures a Client to retry requests failing with a network error,
+// a rate limit error or a server error.
+// Requests with non-idempotent methods are only retried when rate limited.
+// Defaults to 0: requests are sent exactly once unless retries are enabled explicitly.
+func MaxRetries(maxRetries int) ClientOption {
+	return clientOptionFunc(func(c *Client) {
+		if maxRetries < 0 {
+			return
+		}
+
+		c.maxRetries = maxRetries
+	})
+}
+
+// bareDo sends a request, retrying it according to the MaxRetries option.
+// It returns the final response and the number of retries.
+func (c *Client) bareDo(req *http.Request) (*Response, int, error) {
+	maxRetries := c.maxRetries
+	if callOptionsFromContext(req.Context()).noRetry {
+		maxRetries = 0
+	}
+
+	for retries := 0; ; retries++ {
+		if retries > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, retries, err
+			}
+
+			req.Body = body
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			// If we got an error, and the context has been canceled,
+			// the context's error is probably more useful.
+			select {
+			case <-req.Context().Done():
+				return nil, retries, req.Context().Err()
+			default:
+			}
+
+			if retries >= maxRetries || !isIdempotent(req) || !canReplay(req) {
+				return nil, retries, c.redactError(err)
+			}
+		} else if retries >= maxRetries || !shouldRetry(req, resp) {
+			response := newResponse(resp)
+
+			err = c.redactError(CheckResponse(resp))
+			if err != nil {
+				defer resp.Body.Close()
+			}
+
+			return response, retries, err
+		}
+
+		delay := retryDelay(resp, retries)
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+
+			return nil, retries, req.Context().Err()
+
+		case <-timer.C:
+		}
+	}
+}
+
+// shouldRetry reports whether a request should be retried after receiving a response.
+func shouldRetry(req *http.Request, resp *http.Response) bool {
+	if !canReplay(req) {
+		return false
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return true
+
+	case resp.StatusCode >= 500:
+		return isIdempotent(req)
+	}
+
+	return false
+}
+
+// canReplay reports whether the body of a request can be sent again.
+func canReplay(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// isIdempotent reports whether a request can be sent multiple times safely:
+// either its method is idempotent or it carries an idempotency key.
+func isIdempotent(req *http.Request) bool {
+	if req.Header.Get(headerIdempotencyKey) != "" {
+		return true
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	}
+
+	return false
+}
+
+// retryDelay returns how long to wait before retrying a request.
+// The Retry-After header is honored if present, otherwise the delay grows exponentially.
+func retryDelay(resp *http.Response, retries int) time.Duration {
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get(headerRetryAfter)); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second
+		}
+	}
+
+	if retries >= 6 {
+		return retryMaxDelay
+	}
+
+	return retryBaseDelay << retries
+}
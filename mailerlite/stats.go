@@ -2,7 +2,23 @@ package mailerlite
 
 import (
 	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// statsHistoryConcurrency is the maximum number of snapshots fetched in parallel by StatsService.History.
+	statsHistoryConcurrency = 4
+
+	// MaxStatsHistorySteps is the maximum number of snapshots StatsService.History fetches in a single call.
+	MaxStatsHistorySteps = 1000
 )
 
 // StatsService handles communication with the stat related
@@ -13,26 +29,30 @@ type StatsService service
 
 // Stats represents account statistics.
 type Stats struct {
-	Subscribed   int     `json:"subscribed"`
-	Unsubscribed int     `json:"unsubscribed"`
-	Campaigns    int     `json:"campaigns"`
-	SentEmails   int     `json:"sent_emails"`
-	OpenRate     float64 `json:"open_rate"`
-	ClickRate    float64 `json:"click_rate"`
-	BounceRate   float64 `json:"bounce_rate"`
+	Subscribed   WeakInt   `json:"subscribed"`
+	Unsubscribed WeakInt   `json:"unsubscribed"`
+	Campaigns    WeakInt   `json:"campaigns"`
+	SentEmails   WeakInt   `json:"sent_emails"`
+	OpenRate     WeakFloat `json:"open_rate"`
+	ClickRate    WeakFloat `json:"click_rate"`
+	BounceRate   WeakFloat `json:"bounce_rate"`
+
+	Raw json.RawMessage `json:"-"` // Raw JSON of the response, see RetainRaw.
 }
 
 // StatGetOptions specifies the optional parameters to the StatsService.Get method.
 type StatGetOptions struct {
 	// Specify UNIX timestamp if you want to receive stats values at the specific point in the past.
 	// Default: none
-	Timestamp int32 `url:"visibility,omitempty"`
+	Timestamp int64 `url:"timestamp,omitempty"`
 }
 
 // Get basic stats for of account, such as subscribers, open/click rates and so on.
 //
 // MailerLite API docs: https://developers.mailerlite.com/reference/stats
-func (s *StatsService) Get(ctx context.Context, opts *StatGetOptions) (*Stats, *Response, error) {
+func (s *StatsService) Get(ctx context.Context, opts *StatGetOptions, callOpts ...CallOption) (*Stats, *Response, error) {
+	ctx = withOperation(ctx, "StatsService.Get")
+
 	u := "stats"
 
 	u, err := addOptions(u, opts)
@@ -40,7 +60,7 @@ func (s *StatsService) Get(ctx context.Context, opts *StatGetOptions) (*Stats, *
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil, callOpts...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -53,3 +73,181 @@ func (s *StatsService) Get(ctx context.Context, opts *StatGetOptions) (*Stats, *
 
 	return &stats, resp, nil
 }
+
+// StatsSnapshot is the state of account statistics at a point in time.
+type StatsSnapshot struct {
+	Time  time.Time
+	Stats Stats
+}
+
+// StatsDelta is the change of account statistics between two snapshots.
+type StatsDelta struct {
+	From time.Time
+	To   time.Time
+
+	// Net subscriber growth.
+	NetSubscribers int
+
+	// Number of subscribers who unsubscribed.
+	Unsubscribed int
+
+	// Number of campaigns sent.
+	Campaigns int
+
+	// Number of emails sent.
+	SentEmails int
+}
+
+// StatsSeries is a time series of account statistics.
+type StatsSeries struct {
+	// Snapshots in chronological order.
+	Snapshots []StatsSnapshot
+}
+
+// Deltas returns the changes between consecutive snapshots.
+func (s *StatsSeries) Deltas() []StatsDelta {
+	if len(s.Snapshots) < 2 {
+		return nil
+	}
+
+	deltas := make([]StatsDelta, 0, len(s.Snapshots)-1)
+
+	for i := 1; i < len(s.Snapshots); i++ {
+		prev, cur := s.Snapshots[i-1], s.Snapshots[i]
+
+		deltas = append(deltas, StatsDelta{
+			From:           prev.Time,
+			To:             cur.Time,
+			NetSubscribers: int(cur.Stats.Subscribed - prev.Stats.Subscribed),
+			Unsubscribed:   int(cur.Stats.Unsubscribed - prev.Stats.Unsubscribed),
+			Campaigns:      int(cur.Stats.Campaigns - prev.Stats.Campaigns),
+			SentEmails:     int(cur.Stats.SentEmails - prev.Stats.SentEmails),
+		})
+	}
+
+	return deltas
+}
+
+// WriteCSV writes the series as CSV, including the deltas from the previous snapshot.
+func (s *StatsSeries) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	err := cw.Write([]string{
+		"time", "subscribed", "unsubscribed", "campaigns", "sent_emails",
+		"open_rate", "click_rate", "bounce_rate", "net_subscribers", "sent_emails_delta",
+	})
+	if err != nil {
+		return err
+	}
+
+	deltas := s.Deltas()
+
+	for i, snapshot := range s.Snapshots {
+		record := []string{
+			snapshot.Time.Format(time.RFC3339),
+			strconv.Itoa(int(snapshot.Stats.Subscribed)),
+			strconv.Itoa(int(snapshot.Stats.Unsubscribed)),
+			strconv.Itoa(int(snapshot.Stats.Campaigns)),
+			strconv.Itoa(int(snapshot.Stats.SentEmails)),
+			strconv.FormatFloat(float64(snapshot.Stats.OpenRate), 'f', -1, 64),
+			strconv.FormatFloat(float64(snapshot.Stats.ClickRate), 'f', -1, 64),
+			strconv.FormatFloat(float64(snapshot.Stats.BounceRate), 'f', -1, 64),
+			"",
+			"",
+		}
+
+		if i > 0 {
+			record[8] = strconv.Itoa(deltas[i-1].NetSubscribers)
+			record[9] = strconv.Itoa(deltas[i-1].SentEmails)
+		}
+
+		err := cw.Write(record)
+		if err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// History fetches snapshots of account statistics from "from" to "to" (inclusive) at every step.
+// Snapshots are fetched in parallel, with a bounded number of concurrent requests.
+// Each snapshot is a separate request, so History returns an error
+// if the interval has more than MaxStatsHistorySteps steps.
+func (s *StatsService) History(ctx context.Context, from time.Time, to time.Time, step time.Duration, callOpts ...CallOption) (*StatsSeries, error) {
+	if step <= 0 {
+		return nil, errors.New("step must be positive")
+	}
+
+	if to.Before(from) {
+		return nil, errors.New("end of the interval must not be before its start")
+	}
+
+	var times []time.Time
+	for t := from; !t.After(to); t = t.Add(step) {
+		if len(times) == MaxStatsHistorySteps {
+			return nil, fmt.Errorf("interval has more than %d steps", MaxStatsHistorySteps)
+		}
+
+		times = append(times, t)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	series := &StatsSeries{
+		Snapshots: make([]StatsSnapshot, len(times)),
+	}
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+
+	sem := make(chan struct{}, statsHistoryConcurrency)
+
+	for i, t := range times {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+
+		go func(i int, t time.Time) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			stats, _, err := s.Get(ctx, &StatGetOptions{Timestamp: t.Unix()}, nthCallOptions(callOpts, i+1)...)
+			if err != nil {
+				errOnce.Do(func() {
+					firstErr = err
+					cancel()
+				})
+
+				return
+			}
+
+			series.Snapshots[i] = StatsSnapshot{Time: t, Stats: *stats}
+		}(i, t)
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return series, nil
+}
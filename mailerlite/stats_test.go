@@ -0,0 +1,211 @@
+package mailerlite
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestStatGetOptions_Timestamp(t *testing.T) {
+	u, err := addOptions("stats", &StatGetOptions{Timestamp: 1640995200})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "stats?timestamp=1640995200"; u != want {
+		t.Errorf("url: got %q, want %q", u, want)
+	}
+}
+
+func TestStatsService_History(t *testing.T) {
+	client, mux := setup(t)
+
+	var (
+		mu         sync.Mutex
+		timestamps []int64
+		inFlight   int32
+		maxFlight  int32
+	)
+
+	mux.HandleFunc("/stats", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		mu.Lock()
+		if n > maxFlight {
+			maxFlight = n
+		}
+		mu.Unlock()
+
+		timestamp, err := strconv.ParseInt(r.URL.Query().Get("timestamp"), 10, 64)
+		if err != nil {
+			t.Errorf("invalid timestamp: %v", err)
+		}
+
+		mu.Lock()
+		timestamps = append(timestamps, timestamp)
+		mu.Unlock()
+
+		// Keep requests in flight long enough to overlap.
+		time.Sleep(20 * time.Millisecond)
+
+		_, _ = fmt.Fprintf(w, `{"subscribed": %d, "sent_emails": %d}`, timestamp/3600, timestamp/60)
+	})
+
+	from := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(9 * time.Hour)
+
+	series, err := client.Stats.History(context.Background(), from, to, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(series.Snapshots), 10; got != want {
+		t.Fatalf("snapshots: got %d, want %d", got, want)
+	}
+
+	sort.Slice(timestamps, func(i, j int) bool { return timestamps[i] < timestamps[j] })
+
+	for i, snapshot := range series.Snapshots {
+		want := from.Add(time.Duration(i) * time.Hour)
+
+		if !snapshot.Time.Equal(want) {
+			t.Errorf("snapshot %d time: got %v, want %v", i, snapshot.Time, want)
+		}
+
+		if got := int64(snapshot.Stats.Subscribed); got != want.Unix()/3600 {
+			t.Errorf("snapshot %d: stats of another timestamp: %+v", i, snapshot.Stats)
+		}
+
+		if timestamps[i] != want.Unix() {
+			t.Errorf("request %d timestamp: got %d, want %d", i, timestamps[i], want.Unix())
+		}
+	}
+
+	if maxFlight > statsHistoryConcurrency {
+		t.Errorf("concurrent requests: got %d, want at most %d", maxFlight, statsHistoryConcurrency)
+	}
+
+	if maxFlight < 2 {
+		t.Errorf("expected requests to be sent in parallel, got %d concurrent requests", maxFlight)
+	}
+}
+
+func TestStatsService_History_CancelOnError(t *testing.T) {
+	client, mux := setup(t)
+
+	var requests int32
+
+	mux.HandleFunc("/stats", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":{"code":1,"message":"Invalid timestamp"}}`))
+	})
+
+	from := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(99 * time.Hour)
+
+	_, err := client.Stats.History(context.Background(), from, to, time.Hour)
+
+	var errorResponse *ErrorResponse
+	if !errors.As(err, &errorResponse) {
+		t.Fatalf("expected *ErrorResponse, got %v", err)
+	}
+
+	// Workers already sending requests may finish, but no new requests are started after the first error.
+	if got := atomic.LoadInt32(&requests); got > 2*statsHistoryConcurrency {
+		t.Errorf("expected History to stop after the first error, got %d requests", got)
+	}
+}
+
+func TestStatsService_History_Invalid(t *testing.T) {
+	client, _ := setup(t)
+
+	from := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		to   time.Time
+		step time.Duration
+	}{
+		"zero step":      {to: from.Add(time.Hour), step: 0},
+		"reversed":       {to: from.Add(-time.Hour), step: time.Hour},
+		"too many steps": {to: from.Add(MaxStatsHistorySteps * time.Hour), step: time.Hour},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			_, err := client.Stats.History(context.Background(), from, test.to, test.step)
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestStatsSeries_Deltas(t *testing.T) {
+	t0 := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	t1 := t0.Add(24 * time.Hour)
+	t2 := t1.Add(24 * time.Hour)
+
+	series := StatsSeries{
+		Snapshots: []StatsSnapshot{
+			{Time: t0, Stats: Stats{Subscribed: 100, Unsubscribed: 10, Campaigns: 1, SentEmails: 100}},
+			{Time: t1, Stats: Stats{Subscribed: 120, Unsubscribed: 12, Campaigns: 2, SentEmails: 220}},
+			{Time: t2, Stats: Stats{Subscribed: 115, Unsubscribed: 20, Campaigns: 2, SentEmails: 220}},
+		},
+	}
+
+	want := []StatsDelta{
+		{From: t0, To: t1, NetSubscribers: 20, Unsubscribed: 2, Campaigns: 1, SentEmails: 120},
+		{From: t1, To: t2, NetSubscribers: -5, Unsubscribed: 8, Campaigns: 0, SentEmails: 0},
+	}
+
+	if got := series.Deltas(); !reflect.DeepEqual(got, want) {
+		t.Errorf("deltas:\ngot  %+v\nwant %+v", got, want)
+	}
+
+	single := StatsSeries{Snapshots: series.Snapshots[:1]}
+	if got := single.Deltas(); got != nil {
+		t.Errorf("a single snapshot should have no deltas, got %+v", got)
+	}
+}
+
+func TestStatsSeries_WriteCSV(t *testing.T) {
+	t0 := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	series := StatsSeries{
+		Snapshots: []StatsSnapshot{
+			{Time: t0, Stats: Stats{Subscribed: 100, Unsubscribed: 10, Campaigns: 1, SentEmails: 100, OpenRate: 0.5, ClickRate: 0.25, BounceRate: 0.01}},
+			{Time: t0.Add(time.Hour), Stats: Stats{Subscribed: 90, Unsubscribed: 20, Campaigns: 2, SentEmails: 190, OpenRate: 0.4}},
+		},
+	}
+
+	var buf bytes.Buffer
+
+	err := series.WriteCSV(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "time,subscribed,unsubscribed,campaigns,sent_emails,open_rate,click_rate,bounce_rate,net_subscribers,sent_emails_delta\n" +
+		"2022-01-01T00:00:00Z,100,10,1,100,0.5,0.25,0.01,,\n" +
+		"2022-01-01T01:00:00Z,90,20,2,190,0.4,0,0,-10,90\n"
+
+	if got := buf.String(); got != want {
+		t.Errorf("csv:\ngot\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,84 @@
+package mailerlite
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// ErrStopStream can be returned by stream callbacks to stop decoding a response early.
+// It is not returned as an error by the streaming functions.
+var ErrStopStream = errors.New("stop stream")
+
+// Stream sends an API request and decodes the JSON array in the response element by element,
+// calling fn for each element instead of holding the whole array in memory.
+// If fn returns an error, decoding stops and the error is returned (unless it is ErrStopStream).
+//
+// Decoding options of the Client (eg. Location, StrictDecoding and RetainRaw) apply to every element.
+// Responses are never served from or stored in the ResponseCache, since that would require reading the whole body.
+func Stream[T any](c *Client, req *http.Request, fn func(item T) error) (*Response, error) {
+	ctx := req.Context()
+
+	return c.do(req, c.BareDo, func(body io.Reader) error {
+		dec := json.NewDecoder(body)
+
+		return decodeArray(dec, func() error {
+			var item T
+			if err := c.decodeNext(ctx, dec, &item); err != nil {
+				return err
+			}
+
+			return fn(item)
+		})
+	})
+}
+
+// StreamTo is like Stream, but sends the elements on ch.
+// ch is closed when the response has been decoded. Cancel the context of the request to stop early.
+func StreamTo[T any](c *Client, req *http.Request, ch chan<- T) (*Response, error) {
+	defer close(ch)
+
+	ctx := req.Context()
+
+	return Stream(c, req, func(item T) error {
+		select {
+		case ch <- item:
+			return nil
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// decodeArray reads a JSON array from dec, calling next to decode each element.
+// An empty body or null is treated as an empty array.
+func decodeArray(dec *json.Decoder, next func() error) error {
+	tok, err := dec.Token()
+	if err == io.EOF { // nolint: errorlint
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if tok == nil {
+		return nil
+	}
+
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected JSON array, got %v", tok)
+	}
+
+	for dec.More() {
+		if err := next(); err != nil {
+			return err
+		}
+	}
+
+	_, err = dec.Token() // closing ]
+
+	return err
+}
@@ -0,0 +1,158 @@
+package mailerlite
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// writeGroups writes a JSON array of n groups.
+func writeGroups(w http.ResponseWriter, n int) {
+	groups := make([]string, 0, n)
+	for i := 1; i <= n; i++ {
+		groups = append(groups, fmt.Sprintf(`{"id":%d,"name":"Group %d"}`, i, i))
+	}
+
+	_, _ = w.Write([]byte("[" + strings.Join(groups, ",") + "]"))
+}
+
+func TestStream_Stop(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/groups", func(w http.ResponseWriter, r *http.Request) {
+		writeGroups(w, 10000)
+	})
+
+	var capture bytes.Buffer
+
+	req, err := client.NewRequest(context.Background(), http.MethodGet, "groups", nil, WithBodyCapture(&capture))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+
+	_, err = Stream(client, req, func(group Group) error {
+		names = append(names, group.Name)
+
+		if len(names) == 2 {
+			return ErrStopStream
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ErrStopStream should not be returned, got %v", err)
+	}
+
+	if got, want := strings.Join(names, ","), "Group 1,Group 2"; got != want {
+		t.Errorf("groups: got %s, want %s", got, want)
+	}
+
+	if capture.Len() == 0 {
+		t.Error("expected the decoded part of the body to be captured")
+	}
+
+	if strings.Contains(capture.String(), `"Group 10000"`) {
+		t.Error("the rest of the body should not be read after the stream was stopped")
+	}
+}
+
+func TestStream_Error(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/groups", func(w http.ResponseWriter, r *http.Request) {
+		writeGroups(w, 3)
+	})
+
+	req, err := client.NewRequest(context.Background(), http.MethodGet, "groups", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errGroup := errors.New("unexpected group")
+
+	_, err = Stream(client, req, func(group Group) error {
+		return errGroup
+	})
+	if err != errGroup { // nolint: errorlint
+		t.Errorf("expected the callback error, got %v", err)
+	}
+}
+
+func TestStreamTo(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/groups", func(w http.ResponseWriter, r *http.Request) {
+		writeGroups(w, 3)
+	})
+
+	req, err := client.NewRequest(context.Background(), http.MethodGet, "groups", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ch := make(chan Group)
+	errc := make(chan error, 1)
+
+	go func() {
+		_, err := StreamTo(client, req, ch)
+		errc <- err
+	}()
+
+	var names []string
+	for group := range ch { // ch is closed by StreamTo
+		names = append(names, group.Name)
+	}
+
+	if err := <-errc; err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := strings.Join(names, ","), "Group 1,Group 2,Group 3"; got != want {
+		t.Errorf("groups: got %s, want %s", got, want)
+	}
+}
+
+func TestStreamTo_Cancel(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/groups", func(w http.ResponseWriter, r *http.Request) {
+		writeGroups(w, 3)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	req, err := client.NewRequest(ctx, http.MethodGet, "groups", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ch := make(chan Group)
+	errc := make(chan error, 1)
+
+	go func() {
+		_, err := StreamTo(client, req, ch)
+		errc <- err
+	}()
+
+	group := <-ch
+	if group.Name != "Group 1" {
+		t.Errorf("unexpected group: %+v", group)
+	}
+
+	cancel()
+
+	if err := <-errc; err == nil {
+		t.Error("expected an error after the context was canceled")
+	}
+
+	if _, ok := <-ch; ok {
+		t.Error("expected the channel to be closed")
+	}
+}
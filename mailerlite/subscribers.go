@@ -2,6 +2,7 @@ package mailerlite
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 )
@@ -14,23 +15,25 @@ type SubscribersService service
 
 // Subscriber represents an email subscriber.
 type Subscriber struct {
-	ID      int              `json:"id"`
-	Name    string           `json:"name"`
-	Email   string           `json:"email"`
-	Sent    int              `json:"sent"`
-	Opened  int              `json:"opened"`
-	Clicked int              `json:"clicked"`
-	Type    SubscriptionType `json:"type"`
-	// CountryID             string            `json:"country_id"`
-	SignupIP              *string           `json:"signup_ip"`
-	SignupTimestamp       *string           `json:"signup_timestamp"`
-	ConfirmationIP        *string           `json:"confirmation_ip"`
-	ConfirmationTimestamp *string           `json:"confirmation_timestamp"`
+	ID                    int               `json:"id"`
+	Name                  string            `json:"name"`
+	Email                 string            `json:"email"`
+	Sent                  int               `json:"sent"`
+	Opened                int               `json:"opened"`
+	Clicked               int               `json:"clicked"`
+	Type                  SubscriptionType  `json:"type"`
+	CountryID             WeakString        `json:"country_id"`
+	SignupIP              Null[string]      `json:"signup_ip"`
+	SignupTimestamp       Timestamp         `json:"signup_timestamp"`
+	ConfirmationIP        Null[string]      `json:"confirmation_ip"`
+	ConfirmationTimestamp Timestamp         `json:"confirmation_timestamp"`
 	Fields                []SubscriberField `json:"fields"`
 	DateSubscribe         *Timestamp        `json:"date_subscribe"`
 	DateUnsubscribe       *Timestamp        `json:"date_unsubscribe"`
 	DateCreated           Timestamp         `json:"date_created"`
 	DateUpdated           *Timestamp        `json:"date_updated"`
+
+	Raw json.RawMessage `json:"-"` // Raw JSON of the response, see RetainRaw.
 }
 
 // SubscriptionType represents the current state of the subscription.
@@ -46,9 +49,48 @@ const (
 
 // SubscriberField represents a custom field and its value.
 type SubscriberField struct {
-	Key   string `json:"key"`
-	Value string `json:"value"`
-	Type  string `json:"type"`
+	Key   string     `json:"key"`
+	Value FieldValue `json:"value"`
+	Type  FieldType  `json:"type"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The value is interpreted according to the type of the field.
+// Values that do not match the type are kept as TEXT.
+func (f *SubscriberField) UnmarshalJSON(data []byte) error {
+	var field struct {
+		Key   string          `json:"key"`
+		Value json.RawMessage `json:"value"`
+		Type  FieldType       `json:"type"`
+	}
+
+	err := json.Unmarshal(data, &field)
+	if err != nil {
+		return err
+	}
+
+	f.Key = field.Key
+	f.Type = field.Type
+	f.Value = FieldValue{}
+
+	if len(field.Value) == 0 {
+		return nil
+	}
+
+	err = json.Unmarshal(field.Value, &f.Value)
+	if err != nil {
+		return err
+	}
+
+	if f.Value.IsEmpty() || f.Value.Type() == f.Type {
+		return nil
+	}
+
+	if value, err := ParseFieldValue(f.Type, f.Value.Text()); err == nil {
+		f.Value = value
+	}
+
+	return nil
 }
 
 // SubscriberListOptions specifies the optional parameters to the
@@ -62,15 +104,75 @@ type SubscriberListOptions struct {
 // List all subscribers.
 //
 // MailerLite API docs: https://developers.mailerlite.com/reference/subscribers
-func (s *SubscribersService) List(ctx context.Context, opts *SubscriberListOptions) ([]Subscriber, *Response, error) {
+func (s *SubscribersService) List(ctx context.Context, opts *SubscriberListOptions, callOpts ...CallOption) ([]Subscriber, *Response, error) {
+	ctx = withOperation(ctx, "SubscribersService.List")
+
+	req, err := s.listRequest(ctx, opts, callOpts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var subscribers []Subscriber
+	resp, err := s.client.Do(req, &subscribers)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return subscribers, resp, nil
+}
+
+// ListEach lists subscribers like List, but calls fn for each subscriber as it is decoded
+// instead of holding the whole page in memory.
+// Return ErrStopStream from fn to stop early.
+func (s *SubscribersService) ListEach(ctx context.Context, opts *SubscriberListOptions, fn func(subscriber Subscriber) error, callOpts ...CallOption) (*Response, error) {
+	ctx = withOperation(ctx, "SubscribersService.ListEach")
+
+	req, err := s.listRequest(ctx, opts, callOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return Stream(s.client, req, fn)
+}
+
+func (s *SubscribersService) listRequest(ctx context.Context, opts *SubscriberListOptions, callOpts ...CallOption) (*http.Request, error) {
 	u := "subscribers"
 
 	u, err := addOptions(u, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.NewRequest(ctx, http.MethodGet, u, nil, callOpts...)
+}
+
+// SubscriberSearchOptions specifies the optional parameters to the
+// SubscribersService.Search method.
+type SubscriberSearchOptions struct {
+	ListOptions
+}
+
+// Search subscribers by email, name or custom field values.
+//
+// MailerLite API docs: https://developers.mailerlite.com/reference/search-for-subscribers
+func (s *SubscribersService) Search(ctx context.Context, query string, opts *SubscriberSearchOptions, callOpts ...CallOption) ([]Subscriber, *Response, error) {
+	ctx = withOperation(ctx, "SubscribersService.Search")
+
+	u := "subscribers/search"
+
+	if opts == nil {
+		opts = &SubscriberSearchOptions{}
+	}
+
+	u, err := addOptions(u, struct {
+		Query string `url:"query"`
+		*SubscriberSearchOptions
+	}{query, opts})
 	if err != nil {
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil, callOpts...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -84,15 +186,61 @@ func (s *SubscribersService) List(ctx context.Context, opts *SubscriberListOptio
 	return subscribers, resp, nil
 }
 
-// TODO: create
+// NewSubscriber represents a new subscriber.
+type NewSubscriber struct {
+	Email          string                `json:"email,omitempty"`
+	Name           string                `json:"name,omitempty"`
+	Fields         map[string]FieldValue `json:"fields,omitempty"`
+	Resubscribe    *bool                 `json:"resubscribe,omitempty"`
+	AutoResponders *bool                 `json:"autoresponders,omitempty"`
+	Type           SubscriptionType      `json:"type,omitempty"`
+}
+
+// Create a new subscriber (or update an existing one) without adding it to a group.
+//
+// MailerLite API docs: https://developers.mailerlite.com/reference/create-a-subscriber
+func (s *SubscribersService) Create(ctx context.Context, newSubscriber NewSubscriber, callOpts ...CallOption) (*Subscriber, *Response, error) {
+	ctx = withOperation(ctx, "SubscribersService.Create")
+
+	email, err := s.client.validateEmail(newSubscriber.Email)
+	if err != nil {
+		return nil, nil, err
+	}
+	newSubscriber.Email = email
+
+	if err := s.client.checkSuppressed(ctx, newSubscriber.Email); err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.client.checkFieldValues(ctx, newSubscriber.Fields); err != nil {
+		return nil, nil, err
+	}
+
+	u := "subscribers"
+
+	req, err := s.client.NewRequest(ctx, http.MethodPost, u, newSubscriber, callOpts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var subscriber Subscriber
+	resp, err := s.client.Do(req, &subscriber)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &subscriber, resp, nil
+}
 
 // Get fetches a subscriber.
 //
 // MailerLite API docs: https://developers.mailerlite.com/reference/single-subscriber
-func (s *SubscribersService) Get(ctx context.Context, email string) (*Subscriber, *Response, error) {
-	u := fmt.Sprintf("subscribers/%s", email)
+func (s *SubscribersService) Get(ctx context.Context, email string, callOpts ...CallOption) (*Subscriber, *Response, error) {
+	ctx = withOperation(ctx, "SubscribersService.Get")
+
+	u := fmt.Sprintf("subscribers/%s", pathEscape(email))
 
-	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil, callOpts...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -117,10 +265,34 @@ type SubscriberUpdate struct {
 // Update updates a subscriber.
 //
 // MailerLite API docs: https://developers.mailerlite.com/reference/update-subscriber
-func (s *SubscribersService) Update(ctx context.Context, email string, update SubscriberUpdate) (*Subscriber, *Response, error) {
-	u := fmt.Sprintf("subscribers/%s", email)
+func (s *SubscribersService) Update(ctx context.Context, email string, update SubscriberUpdate, callOpts ...CallOption) (*Subscriber, *Response, error) {
+	ctx = withOperation(ctx, "SubscribersService.Update")
 
-	req, err := s.client.NewRequest(ctx, http.MethodPut, u, update)
+	email, err := s.client.validateEmail(email)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if update.Type == Active {
+		if err := s.client.checkSuppressed(ctx, email); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if len(update.Fields) > 0 {
+		values := make(map[string]FieldValue, len(update.Fields))
+		for _, field := range update.Fields {
+			values[field.Key] = field.Value
+		}
+
+		if err := s.client.checkFieldValues(ctx, values); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	u := fmt.Sprintf("subscribers/%s", pathEscape(email))
+
+	req, err := s.client.NewRequest(ctx, http.MethodPut, u, update, callOpts...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -133,3 +305,25 @@ func (s *SubscribersService) Update(ctx context.Context, email string, update Su
 
 	return &subscriber, resp, nil
 }
+
+// Groups lists the groups a subscriber belongs to.
+//
+// MailerLite API docs: https://developers.mailerlite.com/reference/groups-subscriber-belongs-to
+func (s *SubscribersService) Groups(ctx context.Context, email string, callOpts ...CallOption) ([]Group, *Response, error) {
+	ctx = withOperation(ctx, "SubscribersService.Groups")
+
+	u := fmt.Sprintf("subscribers/%s/groups", pathEscape(email))
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil, callOpts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var groups []Group
+	resp, err := s.client.Do(req, &groups)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return groups, resp, nil
+}
@@ -0,0 +1,98 @@
+package mailerlite
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestSubscribersService_Create(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/subscribers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+
+		want := map[string]interface{}{
+			"email":       "john@example.com",
+			"name":        "John",
+			"fields":      map[string]interface{}{"seats": 5.0},
+			"resubscribe": false,
+		}
+		if !reflect.DeepEqual(body, want) {
+			t.Errorf("request body: got %v, want %v", body, want)
+		}
+
+		_, _ = w.Write([]byte(`{"id":1,"email":"john@example.com","name":"John","type":"active"}`))
+	})
+
+	resubscribe := false
+
+	subscriber, _, err := client.Subscribers.Create(context.Background(), NewSubscriber{
+		Email:       "john@example.com",
+		Name:        "John",
+		Fields:      map[string]FieldValue{"seats": NumberValue(5)},
+		Resubscribe: &resubscribe,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if subscriber.ID != 1 || subscriber.Type != Active {
+		t.Errorf("unexpected subscriber: %+v", subscriber)
+	}
+}
+
+func TestSubscribersService_Groups(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/subscribers/john+news@example.com/groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		if got, want := r.URL.EscapedPath(), "/subscribers/john%2Bnews@example.com/groups"; got != want {
+			t.Errorf("request path: got %s, want %s", got, want)
+		}
+
+		_, _ = w.Write([]byte(`[{"id":1,"name":"Customers"},{"id":2,"name":"Leads"}]`))
+	})
+
+	groups, _, err := client.Subscribers.Groups(context.Background(), "john+news@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(groups) != 2 || groups[0].ID != 1 || groups[1].Name != "Leads" {
+		t.Errorf("unexpected groups: %+v", groups)
+	}
+}
+
+func TestSubscribersService_Search(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/subscribers/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		if got, want := r.URL.RawQuery, "limit=10&query=john+doe"; got != want {
+			t.Errorf("query: got %s, want %s", got, want)
+		}
+
+		_, _ = w.Write([]byte(`[{"id":1,"email":"john@example.com","name":"John Doe"}]`))
+	})
+
+	subscribers, _, err := client.Subscribers.Search(context.Background(), "john doe", &SubscriberSearchOptions{
+		ListOptions: ListOptions{Limit: 10},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(subscribers) != 1 || subscribers[0].Name != "John Doe" {
+		t.Errorf("unexpected subscribers: %+v", subscribers)
+	}
+}
@@ -0,0 +1,585 @@
+package mailerlite
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// SuppressionsService handles the email addresses MailerLite does not send emails to.
+//
+// The MailerLite API has no dedicated suppression list (blocklist) endpoints:
+// subscribers who unsubscribed, bounced or were marked as junk are never sent emails,
+// so the suppression list of an account is made up of those subscribers.
+// Removing an address from the suppression list resubscribes the subscriber,
+// which is only possible for subscribers who unsubscribed (bounced and junk addresses cannot be lifted).
+//
+// The API cannot suppress domains either: domain methods act on the existing subscribers of a domain.
+// To refuse addresses of a domain added later, use a SuppressionList with SuppressionGuard.
+//
+// MailerLite API docs: https://developers.mailerlite.com/reference/subscribers
+type SuppressionsService service
+
+// Suppression is an email address that must not receive emails.
+type Suppression struct {
+	Email string
+
+	// Reason is the subscription type of the subscriber (Unsubscribed, Bounced or Junk).
+	Reason SubscriptionType
+
+	// Date is when the subscription type changed (if known).
+	Date *Timestamp
+}
+
+// suppressionReasons are the subscription types of subscribers who are not sent emails.
+var suppressionReasons = []SubscriptionType{Unsubscribed, Bounced, Junk}
+
+// newSuppression returns the suppression of a subscriber.
+func newSuppression(subscriber Subscriber) Suppression {
+	suppression := Suppression{
+		Email:  subscriber.Email,
+		Reason: subscriber.Type,
+		Date:   subscriber.DateUpdated,
+	}
+
+	if subscriber.Type == Unsubscribed && subscriber.DateUnsubscribe != nil {
+		suppression.Date = subscriber.DateUnsubscribe
+	}
+
+	return suppression
+}
+
+// SuppressionListOptions specifies the optional parameters to the
+// SuppressionsService.List method.
+type SuppressionListOptions struct {
+	// Reason of the suppressions to list (Unsubscribed, Bounced or Junk). Defaults to Unsubscribed.
+	Reason SubscriptionType
+
+	ListOptions
+}
+
+// List suppressed email addresses (subscribers of the given reason).
+//
+// MailerLite API docs: https://developers.mailerlite.com/reference/subscribers
+func (s *SuppressionsService) List(ctx context.Context, opts *SuppressionListOptions, callOpts ...CallOption) ([]Suppression, *Response, error) {
+	ctx = withOperation(ctx, "SuppressionsService.List")
+
+	var suppressions []Suppression
+
+	resp, err := s.listEach(ctx, opts, func(suppression Suppression) error {
+		suppressions = append(suppressions, suppression)
+
+		return nil
+	}, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return suppressions, resp, nil
+}
+
+// ListEach lists suppressions like List, but calls fn for each suppression as it is decoded
+// instead of holding the whole page in memory.
+// Return ErrStopStream from fn to stop early.
+func (s *SuppressionsService) ListEach(ctx context.Context, opts *SuppressionListOptions, fn func(suppression Suppression) error, callOpts ...CallOption) (*Response, error) {
+	ctx = withOperation(ctx, "SuppressionsService.ListEach")
+
+	return s.listEach(ctx, opts, fn, callOpts...)
+}
+
+func (s *SuppressionsService) listEach(ctx context.Context, opts *SuppressionListOptions, fn func(suppression Suppression) error, callOpts ...CallOption) (*Response, error) {
+	if opts == nil {
+		opts = &SuppressionListOptions{}
+	}
+
+	reason := opts.Reason
+	if reason == "" {
+		reason = Unsubscribed
+	}
+
+	if !isSuppressionReason(reason) {
+		return nil, fmt.Errorf("invalid suppression reason %q", reason)
+	}
+
+	u, err := addOptions("subscribers", &SubscriberListOptions{Type: reason, ListOptions: opts.ListOptions})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil, callOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return Stream(s.client, req, func(subscriber Subscriber) error {
+		return fn(newSuppression(subscriber))
+	})
+}
+
+func isSuppressionReason(typ SubscriptionType) bool {
+	for _, reason := range suppressionReasons {
+		if typ == reason {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Add email addresses to the suppression list by creating (or updating) them as unsubscribed subscribers.
+// Addresses that are not subscribers yet are created in the account as unsubscribed subscribers,
+// and existing subscribers are unsubscribed.
+// Adding stops at the first error, returning the suppressions added so far.
+//
+// MailerLite API docs: https://developers.mailerlite.com/reference/create-a-subscriber
+func (s *SuppressionsService) Add(ctx context.Context, emails []string, callOpts ...CallOption) ([]Suppression, *Response, error) {
+	ctx = withOperation(ctx, "SuppressionsService.Add")
+
+	return s.add(ctx, emails, sequenceCallOptions(callOpts)...)
+}
+
+func (s *SuppressionsService) add(ctx context.Context, emails []string, callOpts ...CallOption) ([]Suppression, *Response, error) {
+	var suppressions []Suppression
+	var resp *Response
+
+	for _, email := range emails {
+		email, err := s.client.validateEmail(email)
+		if err != nil {
+			return suppressions, resp, err
+		}
+
+		req, err := s.client.NewRequest(ctx, http.MethodPost, "subscribers", NewSubscriber{
+			Email: email,
+			Type:  Unsubscribed,
+		}, callOpts...)
+		if err != nil {
+			return suppressions, resp, err
+		}
+
+		var subscriber Subscriber
+		resp, err = s.client.Do(req, &subscriber)
+		if err != nil {
+			return suppressions, resp, err
+		}
+
+		suppressions = append(suppressions, newSuppression(subscriber))
+	}
+
+	return suppressions, resp, nil
+}
+
+// Remove email addresses from the suppression list by resubscribing them.
+// Only subscribers who unsubscribed can be resubscribed: bounced and junk addresses
+// are rejected with a *SuppressionRemovalError. Addresses that are not suppressed are skipped.
+// The SuppressionGuard of the Client is not consulted, since removing is explicit.
+// Removing stops at the first error, returning the addresses removed so far.
+//
+// MailerLite API docs: https://developers.mailerlite.com/reference/update-a-subscriber
+func (s *SuppressionsService) Remove(ctx context.Context, emails []string, callOpts ...CallOption) ([]string, *Response, error) {
+	ctx = withOperation(ctx, "SuppressionsService.Remove")
+
+	return s.remove(ctx, emails, sequenceCallOptions(callOpts)...)
+}
+
+func (s *SuppressionsService) remove(ctx context.Context, emails []string, callOpts ...CallOption) ([]string, *Response, error) {
+	var removed []string
+	var resp *Response
+
+	for _, email := range emails {
+		email, err := s.client.validateEmail(email)
+		if err != nil {
+			return removed, resp, err
+		}
+
+		var ok bool
+
+		ok, resp, err = s.resubscribe(ctx, email, callOpts...)
+		if err != nil {
+			return removed, resp, err
+		}
+
+		if ok {
+			removed = append(removed, email)
+		}
+	}
+
+	return removed, resp, nil
+}
+
+// resubscribe resubscribes an unsubscribed subscriber. It reports whether the subscriber was suppressed.
+func (s *SuppressionsService) resubscribe(ctx context.Context, email string, callOpts ...CallOption) (bool, *Response, error) {
+	u := fmt.Sprintf("subscribers/%s", pathEscape(email))
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil, callOpts...)
+	if err != nil {
+		return false, nil, err
+	}
+
+	var subscriber Subscriber
+	resp, err := s.client.Do(req, &subscriber)
+	if IsNotFound(err) {
+		return false, resp, nil
+	}
+	if err != nil {
+		return false, resp, err
+	}
+
+	switch subscriber.Type {
+	case Bounced, Junk:
+		return false, resp, s.client.redactError(&SuppressionRemovalError{Email: email, Reason: subscriber.Type})
+
+	case Unsubscribed:
+		// resubscribe below
+
+	default:
+		return false, resp, nil // not suppressed
+	}
+
+	req, err = s.client.NewRequest(ctx, http.MethodPut, u, SubscriberUpdate{Type: Active}, callOpts...)
+	if err != nil {
+		return false, resp, err
+	}
+
+	resp, err = s.client.Do(req, nil)
+	if err != nil {
+		return false, resp, err
+	}
+
+	return true, resp, nil
+}
+
+// SuppressionRemovalError is returned when a suppressed email address cannot be removed from the suppression list.
+type SuppressionRemovalError struct {
+	Email string
+
+	// Reason is the subscription type of the subscriber (Bounced or Junk).
+	Reason SubscriptionType
+
+	redact RedactionPolicy
+}
+
+func (e *SuppressionRemovalError) Error() string {
+	return redactString(e.redact, e.Unredacted())
+}
+
+// Unredacted returns the error message without applying the redaction policy of the Client.
+func (e *SuppressionRemovalError) Unredacted() string {
+	return fmt.Sprintf("%s cannot be removed from the suppression list: subscriber is %s", e.Email, e.Reason)
+}
+
+// ListDomain lists the suppressed email addresses of a domain.
+func (s *SuppressionsService) ListDomain(ctx context.Context, domain string, callOpts ...CallOption) ([]Suppression, error) {
+	ctx = withOperation(ctx, "SuppressionsService.ListDomain")
+
+	return s.listDomain(ctx, domain, sequenceCallOptions(callOpts)...)
+}
+
+func (s *SuppressionsService) listDomain(ctx context.Context, domain string, callOpts ...CallOption) ([]Suppression, error) {
+	domain, err := suppressedDomain(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	var suppressions []Suppression
+
+	err = s.each(ctx, func(suppression Suppression) {
+		if emailDomain(suppression.Email) == domain {
+			suppressions = append(suppressions, suppression)
+		}
+	}, callOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return suppressions, nil
+}
+
+// AddDomain suppresses every existing subscriber of a domain by unsubscribing them.
+// Addresses of the domain added later are not suppressed (see SuppressionList.AddDomain).
+// It returns the suppressions added (subscribers already unsubscribed, bounced or junk are left untouched).
+//
+// MailerLite API docs: https://developers.mailerlite.com/reference/search-for-subscribers
+func (s *SuppressionsService) AddDomain(ctx context.Context, domain string, callOpts ...CallOption) ([]Suppression, *Response, error) {
+	ctx = withOperation(ctx, "SuppressionsService.AddDomain")
+
+	domain, err := suppressedDomain(domain)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	callOpts = sequenceCallOptions(callOpts)
+
+	var emails []string
+
+	opts := ListOptions{Limit: 1000}
+
+	for {
+		u, err := addOptions("subscribers/search", struct {
+			Query string `url:"query"`
+			ListOptions
+		}{"@" + domain, opts})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil, callOpts...)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var subscribers []Subscriber
+		resp, err := s.client.Do(req, &subscribers)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		for _, subscriber := range subscribers {
+			if emailDomain(subscriber.Email) == domain && !isSuppressionReason(subscriber.Type) {
+				emails = append(emails, subscriber.Email)
+			}
+		}
+
+		if len(subscribers) < opts.Limit {
+			break
+		}
+
+		opts.Offset += len(subscribers)
+	}
+
+	return s.add(ctx, emails, callOpts...)
+}
+
+// RemoveDomain removes every unsubscribed address of a domain from the suppression list by resubscribing them.
+// Bounced and junk addresses of the domain are left untouched.
+// It returns the addresses removed.
+func (s *SuppressionsService) RemoveDomain(ctx context.Context, domain string, callOpts ...CallOption) ([]string, *Response, error) {
+	ctx = withOperation(ctx, "SuppressionsService.RemoveDomain")
+
+	callOpts = sequenceCallOptions(callOpts)
+
+	suppressions, err := s.listDomain(ctx, domain, callOpts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var emails []string
+
+	for _, suppression := range suppressions {
+		if suppression.Reason == Unsubscribed {
+			emails = append(emails, suppression.Email)
+		}
+	}
+
+	return s.remove(ctx, emails, callOpts...)
+}
+
+// suppressedDomain normalizes a domain passed to the domain methods.
+func suppressedDomain(domain string) (string, error) {
+	domain, reason := normalizeDomain(strings.TrimPrefix(strings.TrimSpace(domain), "@"))
+	if reason != "" {
+		return "", fmt.Errorf("invalid domain: %s", reason)
+	}
+
+	return domain, nil
+}
+
+// emailDomain returns the lowercase domain of an email address.
+func emailDomain(email string) string {
+	return normalizeSuppressed(email[strings.LastIndex(email, "@")+1:])
+}
+
+// Load fetches the whole suppression list of the account
+// (every unsubscribed, bounced and junk subscriber).
+func (s *SuppressionsService) Load(ctx context.Context, callOpts ...CallOption) (*SuppressionList, error) {
+	ctx = withOperation(ctx, "SuppressionsService.Load")
+
+	return s.load(ctx, callOpts...)
+}
+
+func (s *SuppressionsService) load(ctx context.Context, callOpts ...CallOption) (*SuppressionList, error) {
+	list := NewSuppressionList()
+
+	err := s.each(ctx, func(suppression Suppression) {
+		list.Add(suppression.Email)
+	}, sequenceCallOptions(callOpts)...)
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// each calls fn for every suppression of the account, paging through every suppression reason.
+func (s *SuppressionsService) each(ctx context.Context, fn func(suppression Suppression), callOpts ...CallOption) error {
+	for _, reason := range suppressionReasons {
+		opts := &SuppressionListOptions{
+			Reason:      reason,
+			ListOptions: ListOptions{Limit: 1000},
+		}
+
+		for {
+			var n int
+
+			_, err := s.listEach(ctx, opts, func(suppression Suppression) error {
+				fn(suppression)
+
+				n++
+
+				return nil
+			}, callOpts...)
+			if err != nil {
+				return err
+			}
+
+			if n < opts.Limit {
+				break
+			}
+
+			opts.Offset += n
+		}
+	}
+
+	return nil
+}
+
+// Check returns the email addresses that are on the suppression list of the account.
+func (s *SuppressionsService) Check(ctx context.Context, emails []string, callOpts ...CallOption) ([]string, error) {
+	ctx = withOperation(ctx, "SuppressionsService.Check")
+
+	list, err := s.load(ctx, callOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return list.Check(emails), nil
+}
+
+// SuppressionChecker decides whether an email address must not be added to the account.
+type SuppressionChecker interface {
+	IsSuppressed(ctx context.Context, email string) (bool, error)
+}
+
+// SuppressionList is an in-memory set of suppressed email addresses and domains.
+// It can hold the suppression list of an account, a global do-not-contact list, or both.
+type SuppressionList struct {
+	mu      sync.RWMutex
+	emails  map[string]bool
+	domains map[string]bool
+}
+
+// NewSuppressionList returns a new SuppressionList containing the given email addresses.
+func NewSuppressionList(emails ...string) *SuppressionList {
+	list := &SuppressionList{
+		emails:  make(map[string]bool),
+		domains: make(map[string]bool),
+	}
+
+	for _, email := range emails {
+		list.Add(email)
+	}
+
+	return list
+}
+
+// Add an email address to the list.
+func (l *SuppressionList) Add(email string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.emails[normalizeSuppressed(email)] = true
+}
+
+// AddDomain adds a domain to the list, suppressing every address of the domain.
+func (l *SuppressionList) AddDomain(domain string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.domains[normalizeSuppressed(strings.TrimPrefix(domain, "@"))] = true
+}
+
+// Contains reports whether an email address (or its domain) is on the list.
+func (l *SuppressionList) Contains(email string) bool {
+	email = normalizeSuppressed(email)
+
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	if l.emails[email] {
+		return true
+	}
+
+	if i := strings.LastIndex(email, "@"); i >= 0 {
+		return l.domains[email[i+1:]]
+	}
+
+	return false
+}
+
+// Check returns the email addresses that are on the list.
+func (l *SuppressionList) Check(emails []string) []string {
+	var suppressed []string
+
+	for _, email := range emails {
+		if l.Contains(email) {
+			suppressed = append(suppressed, email)
+		}
+	}
+
+	return suppressed
+}
+
+// IsSuppressed implements the SuppressionChecker interface.
+func (l *SuppressionList) IsSuppressed(_ context.Context, email string) (bool, error) {
+	return l.Contains(email), nil
+}
+
+func normalizeSuppressed(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
+
+// SuppressedError is returned when trying to add or resubscribe a suppressed email address.
+type SuppressedError struct {
+	Email string
+
+	redact RedactionPolicy
+}
+
+func (e *SuppressedError) Error() string {
+	return redactString(e.redact, e.Unredacted())
+}
+
+// Unredacted returns the error message without applying the redaction policy of the Client.
+func (e *SuppressedError) Unredacted() string {
+	return fmt.Sprintf("%s is on the suppression list", e.Email)
+}
+
+// SuppressionGuard configures a Client to refuse adding or resubscribing suppressed email addresses.
+//
+// SubscribersService.Create, GroupsService.AddSubscriber and SubscribersService.Update
+// (when changing the subscription type to active) consult the checker before sending any request,
+// and return a *SuppressedError for suppressed addresses.
+func SuppressionGuard(checker SuppressionChecker) ClientOption {
+	return clientOptionFunc(func(c *Client) {
+		c.suppressionChecker = checker
+	})
+}
+
+// checkSuppressed returns a *SuppressedError if an email address is suppressed.
+func (c *Client) checkSuppressed(ctx context.Context, email string) error {
+	if c.suppressionChecker == nil {
+		return nil
+	}
+
+	suppressed, err := c.suppressionChecker.IsSuppressed(ctx, email)
+	if err != nil {
+		return fmt.Errorf("checking suppression list: %w", err)
+	}
+
+	if suppressed {
+		return c.redactError(&SuppressedError{Email: email})
+	}
+
+	return nil
+}
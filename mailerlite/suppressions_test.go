@@ -0,0 +1,237 @@
+package mailerlite
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestSuppressionsService_Load(t *testing.T) {
+	client, mux := setup(t)
+
+	subscribers := map[string]string{
+		"unsubscribed": `[{"email": "john@example.com", "type": "unsubscribed"}]`,
+		"bounced":      `[{"email": "jane@example.com", "type": "bounced"}]`,
+		"junk":         `[]`,
+	}
+
+	mux.HandleFunc("/subscribers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		body, ok := subscribers[r.URL.Query().Get("type")]
+		if !ok {
+			t.Errorf("unexpected subscriber type: %q", r.URL.Query().Get("type"))
+		}
+
+		_, _ = w.Write([]byte(body))
+	})
+
+	list, err := client.Suppressions.Load(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !list.Contains("John@example.com") || !list.Contains("jane@example.com") || list.Contains("joe@example.com") {
+		t.Error("unexpected suppression list")
+	}
+}
+
+func TestSuppressionsService_Add(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/subscribers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var newSubscriber NewSubscriber
+		if err := json.NewDecoder(r.Body).Decode(&newSubscriber); err != nil {
+			t.Fatal(err)
+		}
+
+		if newSubscriber.Type != Unsubscribed {
+			t.Errorf("subscription type: got %q, want %q", newSubscriber.Type, Unsubscribed)
+		}
+
+		_, _ = w.Write([]byte(`{"email": "` + newSubscriber.Email + `", "type": "unsubscribed", "date_unsubscribe": "2022-01-01 10:00:00"}`))
+	})
+
+	suppressions, _, err := client.Suppressions.Add(context.Background(), []string{"john@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(suppressions) != 1 || suppressions[0].Email != "john@example.com" || suppressions[0].Reason != Unsubscribed || suppressions[0].Date == nil {
+		t.Errorf("unexpected suppressions: %+v", suppressions)
+	}
+}
+
+func TestSuppressionsService_List_InvalidReason(t *testing.T) {
+	client, _ := setup(t)
+
+	_, _, err := client.Suppressions.List(context.Background(), &SuppressionListOptions{Reason: Active})
+	if err == nil {
+		t.Fatal("expected an error for an invalid reason")
+	}
+}
+
+// operationRecorder records the operations of API calls.
+type operationRecorder struct {
+	operations []string
+}
+
+func (r *operationRecorder) CallStarted(ctx context.Context, call Call) context.Context {
+	r.operations = append(r.operations, call.Operation)
+
+	return ctx
+}
+
+func (r *operationRecorder) CallFinished(context.Context, Call, CallResult) {}
+
+func TestSuppressionsService_Load_Operation(t *testing.T) {
+	recorder := &operationRecorder{}
+
+	client, mux := setup(t, Instrument(recorder))
+
+	mux.HandleFunc("/subscribers", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[]`))
+	})
+
+	if _, err := client.Suppressions.Load(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(recorder.operations) != 3 {
+		t.Fatalf("expected a call per suppression reason, got %q", recorder.operations)
+	}
+
+	for _, operation := range recorder.operations {
+		if operation != "SuppressionsService.Load" {
+			t.Errorf("unexpected operation: %q", operation)
+		}
+	}
+}
+
+func TestSuppressionsService_Remove(t *testing.T) {
+	client, mux := setup(t)
+
+	subscribers := map[string]string{
+		"john@example.com": "unsubscribed",
+		"jane@example.com": "active",
+		"joe@example.com":  "bounced",
+	}
+
+	var updated []string
+
+	mux.HandleFunc("/subscribers/", func(w http.ResponseWriter, r *http.Request) {
+		email := r.URL.Path[len("/subscribers/"):]
+
+		typ, ok := subscribers[email]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error": {"code": 123, "message": "Subscriber not found"}}`))
+
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`{"email": "` + email + `", "type": "` + typ + `"}`))
+
+		case http.MethodPut:
+			var update SubscriberUpdate
+			if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+				t.Fatal(err)
+			}
+
+			if update.Type != Active {
+				t.Errorf("subscription type: got %q, want %q", update.Type, Active)
+			}
+
+			updated = append(updated, email)
+
+			_, _ = w.Write([]byte(`{"email": "` + email + `", "type": "active"}`))
+
+		default:
+			t.Errorf("unexpected %s request", r.Method)
+		}
+	})
+
+	removed, _, err := client.Suppressions.Remove(context.Background(), []string{"john@example.com", "jane@example.com", "nobody@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(removed) != 1 || removed[0] != "john@example.com" || len(updated) != 1 {
+		t.Errorf("unexpected removals: %q (updated %q)", removed, updated)
+	}
+
+	_, _, err = client.Suppressions.Remove(context.Background(), []string{"joe@example.com"})
+
+	var removalErr *SuppressionRemovalError
+	if !errors.As(err, &removalErr) || removalErr.Reason != Bounced {
+		t.Errorf("expected a *SuppressionRemovalError, got %v", err)
+	}
+}
+
+func TestSuppressionsService_Domains(t *testing.T) {
+	client, mux := setup(t)
+
+	var created []string
+
+	mux.HandleFunc("/subscribers/search", func(w http.ResponseWriter, r *http.Request) {
+		if got, want := r.URL.Query().Get("query"), "@example.com"; got != want {
+			t.Errorf("query: got %q, want %q", got, want)
+		}
+
+		_, _ = w.Write([]byte(`[
+			{"email": "john@example.com", "type": "active"},
+			{"email": "jane@example.com", "type": "unsubscribed"},
+			{"email": "joe@example.com.evil.test", "type": "active"}
+		]`))
+	})
+	mux.HandleFunc("/subscribers", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			if r.URL.Query().Get("type") == "unsubscribed" {
+				_, _ = w.Write([]byte(`[{"email": "jane@example.com", "type": "unsubscribed"}, {"email": "joe@other.test", "type": "unsubscribed"}]`))
+
+				return
+			}
+
+			_, _ = w.Write([]byte(`[]`))
+
+		case http.MethodPost:
+			var newSubscriber NewSubscriber
+			if err := json.NewDecoder(r.Body).Decode(&newSubscriber); err != nil {
+				t.Fatal(err)
+			}
+
+			created = append(created, newSubscriber.Email)
+
+			_, _ = w.Write([]byte(`{"email": "` + newSubscriber.Email + `", "type": "unsubscribed"}`))
+		}
+	})
+
+	suppressions, _, err := client.Suppressions.AddDomain(context.Background(), "@Example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(suppressions) != 1 || len(created) != 1 || created[0] != "john@example.com" {
+		t.Errorf("unexpected suppressions: %+v", suppressions)
+	}
+
+	listed, err := client.Suppressions.ListDomain(context.Background(), "example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(listed) != 1 || listed[0].Email != "jane@example.com" {
+		t.Errorf("unexpected domain suppressions: %+v", listed)
+	}
+
+	if _, err := client.Suppressions.ListDomain(context.Background(), "not a domain"); err == nil {
+		t.Error("expected an error for an invalid domain")
+	}
+}
@@ -1,13 +1,37 @@
 package mailerlite
 
 import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"reflect"
 	"strconv"
 	"time"
 )
 
+// timestampLayout is the format of timestamps returned by the API.
+const timestampLayout = "2006-01-02 15:04:05"
+
+// zeroTimestamp is how the API represents missing timestamps in some responses.
+const zeroTimestamp = "0000-00-00 00:00:00"
+
+// wallClockUTC is the location of timestamps decoded without time zone information.
+// It is UTC, but a different *time.Location than time.UTC, so Localize can tell these timestamps apart.
+var wallClockUTC = time.FixedZone("UTC", 0)
+
 // Timestamp represents a time that can be unmarshalled from a JSON string
-// formatted as either an RFC3339 or Unix timestamp.
+// formatted as either "2006-01-02 15:04:05" or Unix timestamp (in seconds or milliseconds).
 // All exported methods of time.Time can be called on Timestamp.
+//
+// Empty values (null, "" and "0000-00-00 00:00:00") are decoded as the zero time,
+// and the zero time is encoded as null.
+//
+// Timestamps in "2006-01-02 15:04:05" format carry no time zone: they are decoded as UTC,
+// unless the Client is configured with the Location of the account.
+//
+// Timestamps decoded without time zone information are in a dedicated UTC location (until they are localized),
+// so two timestamps representing the same instant may differ when compared with == or reflect.DeepEqual.
+// Use Equal to compare timestamps.
 type Timestamp struct {
 	time.Time
 }
@@ -16,23 +40,186 @@ func (t Timestamp) String() string {
 	return t.Time.String()
 }
 
+// MarshalJSON implements the json.Marshaler interface.
+// Time is encoded in "2006-01-02 15:04:05" format.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(t.Format(timestampLayout))
+}
+
 // UnmarshalJSON implements the json.Unmarshaler interface.
-// Time is expected in RFC3339 or Unix format.
+// Time is expected in "2006-01-02 15:04:05" or Unix format.
 func (t *Timestamp) UnmarshalJSON(data []byte) (err error) {
+	data = bytes.TrimSpace(data)
+
+	if bytes.Equal(data, []byte("null")) {
+		*t = Timestamp{}
+
+		return nil
+	}
+
 	str := string(data)
 	i, err := strconv.ParseInt(str, 10, 64)
 	if err == nil {
-		t.Time = time.Unix(i, 0)
-		if t.Time.Year() > 3000 {
-			t.Time = time.Unix(0, i*1e6)
-		}
-	} else {
-		t.Time, err = time.Parse(`"2006-01-02 15:04:05"`, str)
+		*t = Timestamp{Time: unixTime(i)}
+
+		return nil
+	}
+
+	err = json.Unmarshal(data, &str)
+	if err != nil {
+		return valueError(data, t, err)
+	}
+
+	return valueError(data, t, t.UnmarshalText([]byte(str)))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Time is encoded in "2006-01-02 15:04:05" format, the zero time as an empty string.
+func (t Timestamp) MarshalText() ([]byte, error) {
+	if t.IsZero() {
+		return []byte{}, nil
+	}
+
+	return []byte(t.Format(timestampLayout)), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Time is expected in "2006-01-02 15:04:05" or Unix format.
+func (t *Timestamp) UnmarshalText(data []byte) error {
+	str := string(data)
+
+	if str == "" || str == zeroTimestamp {
+		*t = Timestamp{}
+
+		return nil
+	}
+
+	if i, err := strconv.ParseInt(str, 10, 64); err == nil {
+		t.Time = unixTime(i)
+
+		return nil
 	}
-	return
+
+	parsed, err := time.ParseInLocation(timestampLayout, str, wallClockUTC)
+	if err != nil {
+		return err
+	}
+
+	t.Time = parsed
+
+	return nil
+}
+
+// unixTime converts a Unix timestamp to a UTC time.
+// Timestamps that would fall after the year 3000 are interpreted in milliseconds.
+func unixTime(i int64) time.Time {
+	t := time.Unix(i, 0)
+	if t.Year() > 3000 {
+		t = time.UnixMilli(i)
+	}
+
+	return t.UTC()
+}
+
+// EncodeValues implements the query.Encoder interface,
+// so Timestamp can be used in structs encoded as URL query parameters.
+func (t Timestamp) EncodeValues(key string, v *url.Values) error {
+	text, err := t.MarshalText()
+	if err != nil {
+		return err
+	}
+
+	v.Set(key, string(text))
+
+	return nil
 }
 
 // Equal reports whether t and u are equal based on time.Equal.
 func (t Timestamp) Equal(u Timestamp) bool {
 	return t.Time.Equal(u.Time)
 }
+
+// Localize returns the timestamp interpreted in loc.
+// Timestamps decoded without time zone information keep their wall clock time
+// (eg. 2006-01-02 15:04:05 UTC becomes 2006-01-02 15:04:05 in loc),
+// other timestamps (including already localized ones) keep the instant they represent.
+func (t Timestamp) Localize(loc *time.Location) Timestamp {
+	if t.Location() != wallClockUTC || t.IsZero() {
+		return Timestamp{Time: t.Time.In(loc)}
+	}
+
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+
+	return Timestamp{Time: time.Date(year, month, day, hour, min, sec, t.Nanosecond(), loc)}
+}
+
+// Location configures a Client to interpret timestamps without time zone information
+// (returned in the time zone of the account) in loc when decoding responses.
+// Defaults to UTC.
+func Location(loc *time.Location) ClientOption {
+	return clientOptionFunc(func(c *Client) {
+		c.location = loc
+	})
+}
+
+var timestampType = reflect.TypeOf(Timestamp{})
+
+// LocalizeTimestamps interprets every Timestamp in v (decoded without time zone information) in loc.
+// v must be a pointer. It is useful for payloads decoded outside of a Client (eg. webhook events).
+func LocalizeTimestamps(v interface{}, loc *time.Location) {
+	if loc == nil {
+		return
+	}
+
+	localizeTimestamps(reflect.ValueOf(v), loc)
+}
+
+func localizeTimestamps(v reflect.Value, loc *time.Location) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			localizeTimestamps(v.Elem(), loc)
+		}
+
+	case reflect.Struct:
+		if v.Type() == timestampType {
+			if v.CanSet() {
+				v.Set(reflect.ValueOf(v.Interface().(Timestamp).Localize(loc)))
+			}
+
+			return
+		}
+
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				localizeTimestamps(v.Field(i), loc)
+			}
+		}
+
+	case reflect.Slice, reflect.Array:
+		// Byte slices (eg. json.RawMessage) cannot contain timestamps.
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+
+		for i := 0; i < v.Len(); i++ {
+			localizeTimestamps(v.Index(i), loc)
+		}
+
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			elem := reflect.New(iter.Value().Type()).Elem()
+			elem.Set(iter.Value())
+
+			localizeTimestamps(elem, loc)
+
+			v.SetMapIndex(iter.Key(), elem)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package mailerlite
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTimestamp_Unmarshal(t *testing.T) {
+	want := time.Date(2022, time.January, 2, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		json string
+	}{
+		{"layout", `"2022-01-02 10:00:00"`},
+		{"seconds", `1641117600`},
+		{"milliseconds", `1641117600000`},
+		{"seconds string", `"1641117600"`},
+		{"milliseconds string", `"1641117600000"`},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			var ts Timestamp
+			if err := json.Unmarshal([]byte(test.json), &ts); err != nil {
+				t.Fatal(err)
+			}
+
+			if !ts.Time.Equal(want) {
+				t.Errorf("got %s, want %s", ts, want)
+			}
+
+			if name, offset := ts.Zone(); name != "UTC" || offset != 0 {
+				t.Errorf("expected a UTC time, got %s", ts.Location())
+			}
+		})
+	}
+}
+
+func TestTimestamp_Localize(t *testing.T) {
+	loc := time.FixedZone("CET", 3600)
+
+	var decoded Timestamp
+	if err := json.Unmarshal([]byte(`"2022-01-02 10:00:00"`), &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	// Timestamps decoded without time zone information keep their wall clock time.
+	want := time.Date(2022, time.January, 2, 10, 0, 0, 0, loc)
+	if got := decoded.Localize(loc); !got.Time.Equal(want) || got.Location() != loc {
+		t.Errorf("decoded: got %s, want %s", got, want)
+	}
+
+	// Other timestamps (including ones constructed with an unkeyed literal) keep the instant they represent.
+	instant := time.Date(2022, time.January, 2, 10, 0, 0, 0, time.UTC)
+	if got := (Timestamp{instant}).Localize(loc); !got.Time.Equal(instant) || got.Location() != loc {
+		t.Errorf("instant: got %s, want %s", got, instant.In(loc))
+	}
+
+	// Localizing twice does not shift the time again.
+	if got := decoded.Localize(loc).Localize(time.UTC); !got.Time.Equal(want) {
+		t.Errorf("localized twice: got %s, want %s", got, want)
+	}
+}
+
+func TestLocalizeTimestamps(t *testing.T) {
+	loc := time.FixedZone("CET", 3600)
+
+	raw := json.RawMessage(`{"date_created":"2022-01-02 10:00:00"}`)
+
+	var subscriber Subscriber
+	if err := json.Unmarshal(raw, &subscriber); err != nil {
+		t.Fatal(err)
+	}
+
+	subscriber.Raw = append(json.RawMessage(nil), raw...)
+
+	LocalizeTimestamps(&subscriber, loc)
+
+	want := time.Date(2022, time.January, 2, 10, 0, 0, 0, loc)
+	if !subscriber.DateCreated.Time.Equal(want) {
+		t.Errorf("date created: got %s, want %s", subscriber.DateCreated, want)
+	}
+
+	if string(subscriber.Raw) != string(raw) {
+		t.Errorf("raw JSON changed: %s", subscriber.Raw)
+	}
+}
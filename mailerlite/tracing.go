@@ -0,0 +1,139 @@
+package mailerlite
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Tracer starts spans (eg. an OpenTelemetry tracer).
+type Tracer interface {
+	// Start creates a span and a context containing the newly created span.
+	Start(ctx context.Context, spanName string) (context.Context, Span)
+}
+
+// Span represents a single operation within a trace.
+type Span interface {
+	// SetAttribute sets a key-value attribute on the span.
+	SetAttribute(key string, value interface{})
+
+	// RecordError records an error as a span event and marks the span as failed.
+	RecordError(err error)
+
+	// End completes the span.
+	End()
+}
+
+// NewTracingObserver returns an Observer that creates a span for every API call.
+//
+// Spans are named after the service method sending the request (eg. GroupsService.AddSubscriber).
+func NewTracingObserver(tracer Tracer) Observer {
+	return tracingObserver{tracer: tracer}
+}
+
+type tracingObserver struct {
+	tracer Tracer
+}
+
+type spanContextKey struct{}
+
+func (o tracingObserver) CallStarted(ctx context.Context, call Call) context.Context {
+	ctx, span := o.tracer.Start(ctx, call.Operation)
+
+	span.SetAttribute("http.method", call.Method)
+	span.SetAttribute("http.url", call.URL.String())
+
+	return context.WithValue(ctx, spanContextKey{}, span)
+}
+
+func (o tracingObserver) CallFinished(ctx context.Context, _ Call, result CallResult) {
+	span, ok := ctx.Value(spanContextKey{}).(Span)
+	if !ok {
+		return
+	}
+
+	if result.StatusCode != 0 {
+		span.SetAttribute("http.status_code", result.StatusCode)
+	}
+
+	if result.FromCache {
+		span.SetAttribute("mailerlite.cache_hit", true)
+	}
+
+	if result.Retries > 0 {
+		span.SetAttribute("mailerlite.retries", result.Retries)
+	}
+
+	if result.Rate.Limit > 0 {
+		span.SetAttribute("mailerlite.rate_limit.remaining", result.Rate.Remaining)
+	}
+
+	if result.Err != nil {
+		span.RecordError(result.Err)
+	}
+
+	span.End()
+}
+
+// MemoryTracer is a Tracer that keeps finished spans in memory.
+// It is mostly useful in tests.
+type MemoryTracer struct {
+	mu    sync.Mutex
+	spans []RecordedSpan
+}
+
+// RecordedSpan is a span recorded by a MemoryTracer.
+type RecordedSpan struct {
+	Name       string
+	Attributes map[string]interface{}
+	Err        error
+	StartTime  time.Time
+	EndTime    time.Time
+}
+
+// NewMemoryTracer returns a new MemoryTracer.
+func NewMemoryTracer() *MemoryTracer {
+	return &MemoryTracer{}
+}
+
+// Start implements the Tracer interface.
+func (t *MemoryTracer) Start(ctx context.Context, spanName string) (context.Context, Span) {
+	return ctx, &memorySpan{
+		tracer: t,
+		span: RecordedSpan{
+			Name:       spanName,
+			Attributes: make(map[string]interface{}),
+			StartTime:  time.Now(),
+		},
+	}
+}
+
+// Spans returns the finished spans in the order they were ended.
+func (t *MemoryTracer) Spans() []RecordedSpan {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return append([]RecordedSpan(nil), t.spans...)
+}
+
+type memorySpan struct {
+	tracer *MemoryTracer
+	span   RecordedSpan
+}
+
+func (s *memorySpan) SetAttribute(key string, value interface{}) {
+	s.span.Attributes[key] = value
+}
+
+func (s *memorySpan) RecordError(err error) {
+	s.span.Err = err
+}
+
+func (s *memorySpan) End() {
+	s.span.EndTime = time.Now()
+
+	s.tracer.mu.Lock()
+	defer s.tracer.mu.Unlock()
+
+	s.tracer.spans = append(s.tracer.spans, s.span)
+}
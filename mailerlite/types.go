@@ -1,13 +1,21 @@
 package mailerlite
 
 import (
+	"bytes"
 	"encoding/json"
+	"reflect"
 	"strconv"
 )
 
 // WeakInt can be used in places where the return type may be both int and string.
 type WeakInt int
 
+// MarshalJSON implements the json.Marshaler interface.
+// WeakInt is always encoded as a JSON number.
+func (w WeakInt) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Itoa(int(w))), nil
+}
+
 // UnmarshalJSON implements the json.Unmarshaler interface.
 func (w *WeakInt) UnmarshalJSON(data []byte) (err error) {
 	var i int
@@ -21,13 +29,247 @@ func (w *WeakInt) UnmarshalJSON(data []byte) (err error) {
 	var str string
 	err = json.Unmarshal(data, &str)
 	if err != nil {
-		return
+		return valueError(data, w, err)
+	}
+
+	return valueError(data, w, w.UnmarshalText([]byte(str)))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (w WeakInt) MarshalText() ([]byte, error) {
+	return []byte(strconv.Itoa(int(w))), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// An empty string is decoded as zero.
+func (w *WeakInt) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*w = 0
+
+		return nil
 	}
 
-	i64, err := strconv.ParseInt(str, 10, 64)
+	i64, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	*w = WeakInt(i64)
+
+	return nil
+}
+
+// WeakBool can be used in places where the return type may be a boolean, a number (0 or 1) or a string.
+type WeakBool bool
+
+// MarshalJSON implements the json.Marshaler interface.
+// WeakBool is always encoded as a JSON boolean.
+func (w WeakBool) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatBool(bool(w))), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (w *WeakBool) UnmarshalJSON(data []byte) error {
+	var b bool
+	if err := json.Unmarshal(data, &b); err == nil {
+		*w = WeakBool(b)
+
+		return nil
+	}
+
+	var f float64
+	if err := json.Unmarshal(data, &f); err == nil {
+		*w = f != 0
+
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return valueError(data, w, err)
+	}
+
+	return valueError(data, w, w.UnmarshalText([]byte(str)))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (w WeakBool) MarshalText() ([]byte, error) {
+	return []byte(strconv.FormatBool(bool(w))), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// An empty string is decoded as false.
+func (w *WeakBool) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*w = false
+
+		return nil
+	}
+
+	b, err := strconv.ParseBool(string(data))
+	if err != nil {
+		return err
+	}
+
+	*w = WeakBool(b)
+
+	return nil
+}
+
+// WeakFloat can be used in places where the return type may be both float and string.
+type WeakFloat float64
+
+// MarshalJSON implements the json.Marshaler interface.
+// WeakFloat is always encoded as a JSON number.
+func (w WeakFloat) MarshalJSON() ([]byte, error) {
+	return json.Marshal(float64(w))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (w *WeakFloat) UnmarshalJSON(data []byte) (err error) {
+	var f float64
+	err = json.Unmarshal(data, &f)
 	if err == nil {
-		*w = WeakInt(i64)
+		*w = WeakFloat(f)
+
+		return
+	}
+
+	var str string
+	err = json.Unmarshal(data, &str)
+	if err != nil {
+		return valueError(data, w, err)
 	}
 
-	return
+	return valueError(data, w, w.UnmarshalText([]byte(str)))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (w WeakFloat) MarshalText() ([]byte, error) {
+	return []byte(strconv.FormatFloat(float64(w), 'f', -1, 64)), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// An empty string is decoded as zero.
+func (w *WeakFloat) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*w = 0
+
+		return nil
+	}
+
+	f, err := strconv.ParseFloat(string(data), 64)
+	if err != nil {
+		return err
+	}
+
+	*w = WeakFloat(f)
+
+	return nil
+}
+
+// WeakString can be used in places where the return type may be a string, a number or a boolean.
+type WeakString string
+
+// MarshalJSON implements the json.Marshaler interface.
+// WeakString is always encoded as a JSON string.
+func (w WeakString) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(w))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Numbers and booleans are kept in their JSON form, null is decoded as an empty string.
+func (w *WeakString) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+
+	switch {
+	case bytes.Equal(data, []byte("null")):
+		*w = ""
+
+	case len(data) > 0 && data[0] == '"':
+		var str string
+		if err := json.Unmarshal(data, &str); err != nil {
+			return err
+		}
+
+		*w = WeakString(str)
+
+	default:
+		var v interface{}
+		if err := json.Unmarshal(data, &v); err != nil {
+			return err
+		}
+
+		switch v.(type) {
+		case float64, bool:
+			*w = WeakString(data)
+
+		default:
+			return &json.UnmarshalTypeError{Value: string(data), Type: reflect.TypeOf(*w)}
+		}
+	}
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (w WeakString) MarshalText() ([]byte, error) {
+	return []byte(w), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (w *WeakString) UnmarshalText(data []byte) error {
+	*w = WeakString(data)
+
+	return nil
+}
+
+// Null is a value that may be null in the API.
+type Null[T any] struct {
+	Value T
+
+	// Valid is true if the value is not null.
+	Valid bool
+}
+
+// NewNull returns a valid (not null) value.
+func NewNull[T any](v T) Null[T] {
+	return Null[T]{Value: v, Valid: true}
+}
+
+// Ptr returns a pointer to the value, or nil if the value is null.
+func (n Null[T]) Ptr() *T {
+	if !n.Valid {
+		return nil
+	}
+
+	return &n.Value
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// Invalid values are encoded as null.
+func (n Null[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(n.Value)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (n *Null[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*n = Null[T]{}
+
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*n = NewNull(v)
+
+	return nil
 }
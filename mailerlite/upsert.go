@@ -0,0 +1,294 @@
+package mailerlite
+
+import (
+	"context"
+	"errors"
+	"sort"
+)
+
+// SubscriberUpsert is the desired state of a subscriber.
+type SubscriberUpsert struct {
+	// Name of the subscriber. Left untouched if empty.
+	Name string
+
+	// Custom field values keyed by field key.
+	// Fields not present in the map are left untouched.
+	Fields map[string]FieldValue
+
+	// IDs of the groups the subscriber should belong to.
+	// The subscriber is never removed from other groups, unless they are listed in RemoveFromGroups.
+	Groups []int
+
+	// IDs of the groups the subscriber should not belong to.
+	RemoveFromGroups []int
+
+	// Resubscribe subscribers who unsubscribed.
+	// By default unsubscribed subscribers stay unsubscribed.
+	// Subscribers marked as bounced or junk are never resubscribed.
+	Resubscribe bool
+
+	// Unsubscribe active and unconfirmed subscribers.
+	// Subscribers that do not exist are not created.
+	// Unsubscribe cannot be combined with Resubscribe.
+	Unsubscribe bool
+
+	// SkipUnsubscribed leaves subscribers who unsubscribed or were marked as junk untouched
+	// (see UpsertResult.Skipped) instead of updating their fields and groups.
+	SkipUnsubscribed bool
+
+	// ResendAutoresponders triggers autoresponders again when an existing subscriber is updated.
+	ResendAutoresponders bool
+
+	// DryRun computes the changes without sending any write request.
+	DryRun bool
+}
+
+// UpsertResult describes the outcome of an upsert.
+// For dry runs it describes the changes that would be made.
+type UpsertResult struct {
+	// Subscriber is the state of the subscriber after the upsert
+	// (the state before the upsert for dry runs, nil if the subscriber does not exist).
+	Subscriber *Subscriber
+
+	// Previous is the state of an existing subscriber before the upsert.
+	Previous *Subscriber
+
+	// Created is true if the subscriber did not exist before.
+	Created bool
+
+	// Updated is true if an existing subscriber was changed.
+	Updated bool
+
+	// Update is the update sent to an existing subscriber (nil if it was up to date).
+	Update *SubscriberUpdate
+
+	// Skipped is true if an unsubscribed or junk subscriber was left untouched (see SubscriberUpsert.SkipUnsubscribed).
+	Skipped bool
+
+	// IDs of the groups the subscriber was added to.
+	AddedGroups []int
+
+	// IDs of the groups the subscriber was removed from.
+	RemovedGroups []int
+}
+
+// Upsert creates a subscriber if it does not exist yet, or updates the fields that changed otherwise.
+// No write request is sent if the subscriber is already up to date.
+//
+// The returned response is the response of the last request sent.
+func (s *SubscribersService) Upsert(ctx context.Context, email string, data SubscriberUpsert, callOpts ...CallOption) (*UpsertResult, *Response, error) {
+	ctx = withOperation(ctx, "SubscribersService.Upsert")
+
+	callOpts = sequenceCallOptions(callOpts)
+
+	if data.Resubscribe && data.Unsubscribe {
+		return nil, nil, errors.New("upsert cannot both resubscribe and unsubscribe a subscriber")
+	}
+
+	email, err := s.client.validateEmail(email)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	subscriber, resp, err := s.Get(ctx, email, callOpts...)
+	if IsNotFound(err) {
+		if data.Unsubscribe {
+			return &UpsertResult{}, resp, nil
+		}
+
+		return s.upsertCreate(ctx, email, data, callOpts...)
+	}
+	if err != nil {
+		return nil, resp, err
+	}
+
+	result := &UpsertResult{Subscriber: subscriber, Previous: subscriber}
+
+	if data.SkipUnsubscribed && (subscriber.Type == Unsubscribed || subscriber.Type == Junk) {
+		result.Skipped = true
+
+		return result, resp, nil
+	}
+
+	var (
+		update  SubscriberUpdate
+		changed bool
+	)
+
+	if data.Name != "" && data.Name != subscriber.Name {
+		update.Name = data.Name
+		changed = true
+	}
+
+	current := make(map[string]FieldValue, len(subscriber.Fields))
+	for _, field := range subscriber.Fields {
+		current[field.Key] = field.Value
+	}
+
+	for _, key := range sortedFieldKeys(data.Fields) {
+		value := data.Fields[key]
+
+		if value.Equal(current[key]) {
+			continue
+		}
+
+		update.Fields = append(update.Fields, SubscriberField{Key: key, Value: value, Type: value.Type()})
+		changed = true
+	}
+
+	if data.Resubscribe && subscriber.Type == Unsubscribed {
+		update.Type = Active
+		changed = true
+	}
+
+	if data.Unsubscribe && (subscriber.Type == Active || subscriber.Type == Unconfirmed) {
+		update.Type = Unsubscribed
+		changed = true
+	}
+
+	if changed {
+		if data.ResendAutoresponders {
+			update.ResendAutoresponders = &data.ResendAutoresponders
+		}
+
+		if !data.DryRun {
+			subscriber, resp, err = s.Update(ctx, email, update, callOpts...)
+			if err != nil {
+				return result, resp, err
+			}
+
+			result.Subscriber = subscriber
+		}
+
+		result.Update = &update
+		result.Updated = true
+	}
+
+	if len(data.Groups) == 0 && len(data.RemoveFromGroups) == 0 {
+		return result, resp, nil
+	}
+
+	groups, resp, err := s.Groups(ctx, email, callOpts...)
+	if err != nil {
+		return result, resp, err
+	}
+
+	member := make(map[int]bool, len(groups))
+	for _, group := range groups {
+		member[group.ID] = true
+	}
+
+	var missing []int
+	for _, id := range data.Groups {
+		if !member[id] {
+			missing = append(missing, id)
+		}
+	}
+
+	if data.DryRun {
+		result.AddedGroups = append(result.AddedGroups, missing...)
+	} else {
+		resp, err = s.addToGroups(ctx, email, missing, result, callOpts...)
+		if err != nil {
+			return result, resp, err
+		}
+	}
+
+	for _, id := range data.RemoveFromGroups {
+		if !member[id] {
+			continue
+		}
+
+		if !data.DryRun {
+			resp, err = s.client.Groups.RemoveSubscriber(ctx, id, email, callOpts...)
+			if err != nil {
+				return result, resp, err
+			}
+		}
+
+		result.RemovedGroups = append(result.RemovedGroups, id)
+	}
+
+	return result, resp, nil
+}
+
+func (s *SubscribersService) upsertCreate(ctx context.Context, email string, data SubscriberUpsert, callOpts ...CallOption) (*UpsertResult, *Response, error) {
+	result := &UpsertResult{Created: true}
+
+	if data.DryRun {
+		result.AddedGroups = append(result.AddedGroups, data.Groups...)
+
+		return result, nil, nil
+	}
+
+	resubscribe := false
+
+	if len(data.Groups) == 0 {
+		subscriber, resp, err := s.Create(ctx, NewSubscriber{
+			Email:       email,
+			Name:        data.Name,
+			Fields:      data.Fields,
+			Resubscribe: &resubscribe,
+		}, callOpts...)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		result.Subscriber = subscriber
+
+		return result, resp, nil
+	}
+
+	// Adding the subscriber to the first group creates it
+	subscriber, resp, err := s.client.Groups.AddSubscriber(ctx, data.Groups[0], NewSubscriberInGroup{
+		Email:       email,
+		Name:        data.Name,
+		Fields:      data.Fields,
+		Resubscribe: &resubscribe,
+	}, callOpts...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	result.Subscriber = subscriber
+	result.AddedGroups = append(result.AddedGroups, data.Groups[0])
+
+	resp, err = s.addToGroups(ctx, email, data.Groups[1:], result, callOpts...)
+
+	return result, resp, err
+}
+
+// addToGroups adds an existing subscriber to groups without resubscribing it.
+func (s *SubscribersService) addToGroups(ctx context.Context, email string, ids []int, result *UpsertResult, callOpts ...CallOption) (*Response, error) {
+	var resp *Response
+
+	resubscribe := false
+
+	for _, id := range ids {
+		var err error
+
+		_, resp, err = s.client.Groups.AddSubscriber(ctx, id, NewSubscriberInGroup{
+			Email:       email,
+			Resubscribe: &resubscribe,
+		}, callOpts...)
+		if err != nil {
+			return resp, err
+		}
+
+		result.AddedGroups = append(result.AddedGroups, id)
+	}
+
+	return resp, nil
+}
+
+// sortedFieldKeys returns the keys of field values in ascending order.
+func sortedFieldKeys(fields map[string]FieldValue) []string {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,113 @@
+package mailerlite
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestSubscribersService_Upsert(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/subscribers/john@example.com", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`{"id": 1, "email": "john@example.com", "type": "active", "fields": [{"key": "city", "value": "Budapest", "type": "TEXT"}]}`))
+
+		case http.MethodPut:
+			var update SubscriberUpdate
+			if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+				t.Fatal(err)
+			}
+
+			if len(update.Fields) != 2 || update.Fields[0].Key != "company" || update.Fields[1].Key != "zip" {
+				t.Errorf("expected sorted field updates, got %+v", update.Fields)
+			}
+
+			_, _ = w.Write([]byte(`{"id": 1, "email": "john@example.com", "type": "active"}`))
+
+		default:
+			t.Errorf("unexpected %s request", r.Method)
+		}
+	})
+	mux.HandleFunc("/subscribers/john@example.com/groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		_, _ = w.Write([]byte(`[{"id": 2, "name": "Customers"}]`))
+	})
+	mux.HandleFunc("/groups/2/subscribers/john@example.com", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	result, _, err := client.Subscribers.Upsert(context.Background(), "john@example.com", SubscriberUpsert{
+		Fields: map[string]FieldValue{
+			"zip":     TextValue("1234"),
+			"city":    TextValue("Budapest"),
+			"company": TextValue("Acme"),
+		},
+		RemoveFromGroups: []int{2, 3},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Created || !result.Updated || result.Update == nil || len(result.Update.Fields) != 2 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+
+	if len(result.RemovedGroups) != 1 || result.RemovedGroups[0] != 2 {
+		t.Errorf("removed groups: got %v, want [2]", result.RemovedGroups)
+	}
+}
+
+func TestSubscribersService_Upsert_DryRun(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/subscribers/john@example.com", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error": {"code": 404, "message": "Subscriber not found"}}`))
+	})
+
+	result, _, err := client.Subscribers.Upsert(context.Background(), "john@example.com", SubscriberUpsert{
+		Groups: []int{1, 2},
+		DryRun: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !result.Created || result.Subscriber != nil || len(result.AddedGroups) != 2 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+}
+
+func TestSubscribersService_Upsert_IdempotencyKey(t *testing.T) {
+	client, mux := setup(t)
+
+	var keys []string
+
+	mux.HandleFunc("/subscribers/john@example.com", func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get(headerIdempotencyKey))
+
+		_, _ = w.Write([]byte(`{"id": 1, "email": "john@example.com", "type": "active", "fields": []}`))
+	})
+	mux.HandleFunc("/subscribers/john@example.com/groups", func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get(headerIdempotencyKey))
+
+		_, _ = w.Write([]byte(`[]`))
+	})
+
+	_, _, err := client.Subscribers.Upsert(context.Background(), "john@example.com", SubscriberUpsert{RemoveFromGroups: []int{2}}, WithIdempotencyKey("key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(keys) != 2 || keys[0] != "key-1" || keys[1] != "key-2" {
+		t.Errorf("expected a key per request, got %q", keys)
+	}
+}
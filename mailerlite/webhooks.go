@@ -1,7 +1,48 @@
 package mailerlite
 
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
 // WehooksService handles communication with the webhook related
 // methods of the MailerLite API.
 //
 // MailerLite API docs: https://developers.mailerlite.com/reference/get-webhooks-list
 type WebhooksService service
+
+// Webhook represents a webhook subscription.
+type Webhook struct {
+	ID          int        `json:"id"`
+	Event       string     `json:"event"`
+	URL         string     `json:"url"`
+	DateCreated Timestamp  `json:"date_created"`
+	DateUpdated *Timestamp `json:"date_updated"`
+
+	Raw json.RawMessage `json:"-"` // Raw JSON of the response, see RetainRaw.
+}
+
+// List all webhooks.
+//
+// MailerLite API docs: https://developers.mailerlite.com/reference/get-webhooks-list
+func (s *WebhooksService) List(ctx context.Context, callOpts ...CallOption) ([]Webhook, *Response, error) {
+	ctx = withOperation(ctx, "WebhooksService.List")
+
+	u := "webhooks"
+
+	req, err := s.client.NewRequest(ctx, http.MethodGet, u, nil, callOpts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var webhooks struct {
+		Webhooks []Webhook `json:"webhooks"`
+	}
+	resp, err := s.client.Do(req, &webhooks)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return webhooks.Webhooks, resp, nil
+}
@@ -0,0 +1,26 @@
+package mailerlite
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestWebhooksService_List(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/webhooks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		_, _ = w.Write([]byte(`{"webhooks":[{"id":1,"event":"subscriber.unsubscribe","url":"https://example.com/hook","date_created":"2022-01-01 10:00:00","date_updated":null}]}`))
+	})
+
+	webhooks, _, err := client.Webhooks.List(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(webhooks) != 1 || webhooks[0].Event != "subscriber.unsubscribe" || webhooks[0].DateUpdated != nil {
+		t.Errorf("unexpected webhooks: %+v", webhooks)
+	}
+}
@@ -0,0 +1,154 @@
+// Package mailerlitemock provides programmable fakes of the MailerLite API client for testing.
+//
+// Every fake records the calls of its methods and returns the responses scripted
+// by setting the function of the method:
+//
+//	api := mailerlitemock.NewAPI()
+//	api.Subscribers.GetFunc = func(ctx context.Context, email string, callOpts ...mailerlite.CallOption) (*mailerlite.Subscriber, *mailerlite.Response, error) {
+//		return &mailerlite.Subscriber{Email: email, Type: mailerlite.Active}, nil, nil
+//	}
+//
+//	// pass api (a mailerlite.API) to the code under test
+//
+//	calls := api.Subscribers.Calls()
+//
+// Methods without a function return ErrNotImplemented.
+package mailerlitemock
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/sagikazarmark/go-mailerlite/mailerlite"
+)
+
+// ErrNotImplemented is returned by methods of fakes that have no scripted response.
+var ErrNotImplemented = errors.New("mailerlitemock: method not implemented")
+
+// Call is a recorded call of a fake method.
+type Call struct {
+	// Method is the name of the method (eg. "SubscribersService.Get").
+	Method string
+
+	// Args are the arguments of the call, except the context and call options.
+	Args []interface{}
+}
+
+// Recorder records the calls of a fake. The zero value is ready to use.
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *Recorder) record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns the recorded calls in order.
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the recorded calls of a method (eg. "SubscribersService.Get").
+func (r *Recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var calls []Call
+	for _, call := range r.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
+
+// Reset forgets the recorded calls.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = nil
+}
+
+// API is a fake of mailerlite.API.
+type API struct {
+	Campaigns    *CampaignsService
+	Automations  *AutomationsService
+	Forms        *FormsService
+	Subscribers  *SubscribersService
+	Groups       *GroupsService
+	Fields       *FieldsService
+	Webhooks     *WebhooksService
+	Stats        *StatsService
+	Suppressions *SuppressionsService
+}
+
+var _ mailerlite.API = (*API)(nil)
+
+// NewAPI returns a new API with a fake for every service.
+func NewAPI() *API {
+	return &API{
+		Campaigns:    &CampaignsService{},
+		Automations:  &AutomationsService{},
+		Forms:        &FormsService{},
+		Subscribers:  &SubscribersService{},
+		Groups:       &GroupsService{},
+		Fields:       &FieldsService{},
+		Webhooks:     &WebhooksService{},
+		Stats:        &StatsService{},
+		Suppressions: &SuppressionsService{},
+	}
+}
+
+// CampaignsAPI implements the mailerlite.API interface.
+func (a *API) CampaignsAPI() mailerlite.CampaignsAPI {
+	return a.Campaigns
+}
+
+// AutomationsAPI implements the mailerlite.API interface.
+func (a *API) AutomationsAPI() mailerlite.AutomationsAPI {
+	return a.Automations
+}
+
+// FormsAPI implements the mailerlite.API interface.
+func (a *API) FormsAPI() mailerlite.FormsAPI {
+	return a.Forms
+}
+
+// SubscribersAPI implements the mailerlite.API interface.
+func (a *API) SubscribersAPI() mailerlite.SubscribersAPI {
+	return a.Subscribers
+}
+
+// GroupsAPI implements the mailerlite.API interface.
+func (a *API) GroupsAPI() mailerlite.GroupsAPI {
+	return a.Groups
+}
+
+// FieldsAPI implements the mailerlite.API interface.
+func (a *API) FieldsAPI() mailerlite.FieldsAPI {
+	return a.Fields
+}
+
+// WebhooksAPI implements the mailerlite.API interface.
+func (a *API) WebhooksAPI() mailerlite.WebhooksAPI {
+	return a.Webhooks
+}
+
+// StatsAPI implements the mailerlite.API interface.
+func (a *API) StatsAPI() mailerlite.StatsAPI {
+	return a.Stats
+}
+
+// SuppressionsAPI implements the mailerlite.API interface.
+func (a *API) SuppressionsAPI() mailerlite.SuppressionsAPI {
+	return a.Suppressions
+}
@@ -0,0 +1,575 @@
+package mailerlitemock
+
+import (
+	"context"
+	"time"
+
+	"github.com/sagikazarmark/go-mailerlite/mailerlite"
+)
+
+var (
+	_ mailerlite.CampaignsAPI    = (*CampaignsService)(nil)
+	_ mailerlite.AutomationsAPI  = (*AutomationsService)(nil)
+	_ mailerlite.FormsAPI        = (*FormsService)(nil)
+	_ mailerlite.SubscribersAPI  = (*SubscribersService)(nil)
+	_ mailerlite.GroupsAPI       = (*GroupsService)(nil)
+	_ mailerlite.FieldsAPI       = (*FieldsService)(nil)
+	_ mailerlite.WebhooksAPI     = (*WebhooksService)(nil)
+	_ mailerlite.StatsAPI        = (*StatsService)(nil)
+	_ mailerlite.SuppressionsAPI = (*SuppressionsService)(nil)
+)
+
+// CampaignsService is a programmable fake of mailerlite.CampaignsAPI.
+// Set the function of a method to script its response.
+type CampaignsService struct {
+	Recorder
+
+	ListFunc     func(ctx context.Context, status mailerlite.CampaignStatus, opts *mailerlite.CampaignListOptions, callOpts ...mailerlite.CallOption) ([]mailerlite.Campaign, *mailerlite.Response, error)
+	ReportFunc   func(ctx context.Context, id int, callOpts ...mailerlite.CallOption) (*mailerlite.CampaignReport, *mailerlite.Response, error)
+	ActivityFunc func(ctx context.Context, id int, opts *mailerlite.CampaignActivityListOptions, callOpts ...mailerlite.CallOption) ([]mailerlite.CampaignActivity, *mailerlite.Response, error)
+}
+
+// List implements the mailerlite.CampaignsAPI interface.
+func (m *CampaignsService) List(ctx context.Context, status mailerlite.CampaignStatus, opts *mailerlite.CampaignListOptions, callOpts ...mailerlite.CallOption) ([]mailerlite.Campaign, *mailerlite.Response, error) {
+	m.record("CampaignsService.List", status, opts)
+
+	if m.ListFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.ListFunc(ctx, status, opts, callOpts...)
+}
+
+// Report implements the mailerlite.CampaignsAPI interface.
+func (m *CampaignsService) Report(ctx context.Context, id int, callOpts ...mailerlite.CallOption) (*mailerlite.CampaignReport, *mailerlite.Response, error) {
+	m.record("CampaignsService.Report", id)
+
+	if m.ReportFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.ReportFunc(ctx, id, callOpts...)
+}
+
+// Activity implements the mailerlite.CampaignsAPI interface.
+func (m *CampaignsService) Activity(ctx context.Context, id int, opts *mailerlite.CampaignActivityListOptions, callOpts ...mailerlite.CallOption) ([]mailerlite.CampaignActivity, *mailerlite.Response, error) {
+	m.record("CampaignsService.Activity", id, opts)
+
+	if m.ActivityFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.ActivityFunc(ctx, id, opts, callOpts...)
+}
+
+// AutomationsService is a programmable fake of mailerlite.AutomationsAPI.
+// Set the function of a method to script its response.
+type AutomationsService struct {
+	Recorder
+
+	ListFunc     func(ctx context.Context, opts *mailerlite.AutomationListOptions, callOpts ...mailerlite.CallOption) ([]mailerlite.Automation, *mailerlite.Response, error)
+	GetFunc      func(ctx context.Context, id int, callOpts ...mailerlite.CallOption) (*mailerlite.Automation, *mailerlite.Response, error)
+	ActivityFunc func(ctx context.Context, id int, opts *mailerlite.AutomationActivityListOptions, callOpts ...mailerlite.CallOption) ([]mailerlite.AutomationActivity, *mailerlite.Response, error)
+}
+
+// List implements the mailerlite.AutomationsAPI interface.
+func (m *AutomationsService) List(ctx context.Context, opts *mailerlite.AutomationListOptions, callOpts ...mailerlite.CallOption) ([]mailerlite.Automation, *mailerlite.Response, error) {
+	m.record("AutomationsService.List", opts)
+
+	if m.ListFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.ListFunc(ctx, opts, callOpts...)
+}
+
+// Get implements the mailerlite.AutomationsAPI interface.
+func (m *AutomationsService) Get(ctx context.Context, id int, callOpts ...mailerlite.CallOption) (*mailerlite.Automation, *mailerlite.Response, error) {
+	m.record("AutomationsService.Get", id)
+
+	if m.GetFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.GetFunc(ctx, id, callOpts...)
+}
+
+// Activity implements the mailerlite.AutomationsAPI interface.
+func (m *AutomationsService) Activity(ctx context.Context, id int, opts *mailerlite.AutomationActivityListOptions, callOpts ...mailerlite.CallOption) ([]mailerlite.AutomationActivity, *mailerlite.Response, error) {
+	m.record("AutomationsService.Activity", id, opts)
+
+	if m.ActivityFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.ActivityFunc(ctx, id, opts, callOpts...)
+}
+
+// FormsService is a programmable fake of mailerlite.FormsAPI.
+// Set the function of a method to script its response.
+type FormsService struct {
+	Recorder
+
+	ListFunc            func(ctx context.Context, typ mailerlite.FormType, opts *mailerlite.FormListOptions, callOpts ...mailerlite.CallOption) ([]mailerlite.Form, *mailerlite.Response, error)
+	GetFunc             func(ctx context.Context, id int, callOpts ...mailerlite.CallOption) (*mailerlite.Form, *mailerlite.Response, error)
+	SubscribersFunc     func(ctx context.Context, id int, opts *mailerlite.FormSubscriberListOptions, callOpts ...mailerlite.CallOption) ([]mailerlite.FormSubscriber, *mailerlite.Response, error)
+	SubscribersEachFunc func(ctx context.Context, id int, opts *mailerlite.FormSubscriberListOptions, fn func(subscriber mailerlite.FormSubscriber) error, callOpts ...mailerlite.CallOption) (*mailerlite.Response, error)
+}
+
+// List implements the mailerlite.FormsAPI interface.
+func (m *FormsService) List(ctx context.Context, typ mailerlite.FormType, opts *mailerlite.FormListOptions, callOpts ...mailerlite.CallOption) ([]mailerlite.Form, *mailerlite.Response, error) {
+	m.record("FormsService.List", typ, opts)
+
+	if m.ListFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.ListFunc(ctx, typ, opts, callOpts...)
+}
+
+// Get implements the mailerlite.FormsAPI interface.
+func (m *FormsService) Get(ctx context.Context, id int, callOpts ...mailerlite.CallOption) (*mailerlite.Form, *mailerlite.Response, error) {
+	m.record("FormsService.Get", id)
+
+	if m.GetFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.GetFunc(ctx, id, callOpts...)
+}
+
+// Subscribers implements the mailerlite.FormsAPI interface.
+func (m *FormsService) Subscribers(ctx context.Context, id int, opts *mailerlite.FormSubscriberListOptions, callOpts ...mailerlite.CallOption) ([]mailerlite.FormSubscriber, *mailerlite.Response, error) {
+	m.record("FormsService.Subscribers", id, opts)
+
+	if m.SubscribersFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.SubscribersFunc(ctx, id, opts, callOpts...)
+}
+
+// SubscribersEach implements the mailerlite.FormsAPI interface.
+func (m *FormsService) SubscribersEach(ctx context.Context, id int, opts *mailerlite.FormSubscriberListOptions, fn func(subscriber mailerlite.FormSubscriber) error, callOpts ...mailerlite.CallOption) (*mailerlite.Response, error) {
+	m.record("FormsService.SubscribersEach", id, opts)
+
+	if m.SubscribersEachFunc == nil {
+		return nil, ErrNotImplemented
+	}
+
+	return m.SubscribersEachFunc(ctx, id, opts, fn, callOpts...)
+}
+
+// SubscribersService is a programmable fake of mailerlite.SubscribersAPI.
+// Set the function of a method to script its response.
+type SubscribersService struct {
+	Recorder
+
+	ListFunc     func(ctx context.Context, opts *mailerlite.SubscriberListOptions, callOpts ...mailerlite.CallOption) ([]mailerlite.Subscriber, *mailerlite.Response, error)
+	ListEachFunc func(ctx context.Context, opts *mailerlite.SubscriberListOptions, fn func(subscriber mailerlite.Subscriber) error, callOpts ...mailerlite.CallOption) (*mailerlite.Response, error)
+	SearchFunc   func(ctx context.Context, query string, opts *mailerlite.SubscriberSearchOptions, callOpts ...mailerlite.CallOption) ([]mailerlite.Subscriber, *mailerlite.Response, error)
+	CreateFunc   func(ctx context.Context, newSubscriber mailerlite.NewSubscriber, callOpts ...mailerlite.CallOption) (*mailerlite.Subscriber, *mailerlite.Response, error)
+	GetFunc      func(ctx context.Context, email string, callOpts ...mailerlite.CallOption) (*mailerlite.Subscriber, *mailerlite.Response, error)
+	UpdateFunc   func(ctx context.Context, email string, update mailerlite.SubscriberUpdate, callOpts ...mailerlite.CallOption) (*mailerlite.Subscriber, *mailerlite.Response, error)
+	GroupsFunc   func(ctx context.Context, email string, callOpts ...mailerlite.CallOption) ([]mailerlite.Group, *mailerlite.Response, error)
+	UpsertFunc   func(ctx context.Context, email string, data mailerlite.SubscriberUpsert, callOpts ...mailerlite.CallOption) (*mailerlite.UpsertResult, *mailerlite.Response, error)
+}
+
+// List implements the mailerlite.SubscribersAPI interface.
+func (m *SubscribersService) List(ctx context.Context, opts *mailerlite.SubscriberListOptions, callOpts ...mailerlite.CallOption) ([]mailerlite.Subscriber, *mailerlite.Response, error) {
+	m.record("SubscribersService.List", opts)
+
+	if m.ListFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.ListFunc(ctx, opts, callOpts...)
+}
+
+// ListEach implements the mailerlite.SubscribersAPI interface.
+func (m *SubscribersService) ListEach(ctx context.Context, opts *mailerlite.SubscriberListOptions, fn func(subscriber mailerlite.Subscriber) error, callOpts ...mailerlite.CallOption) (*mailerlite.Response, error) {
+	m.record("SubscribersService.ListEach", opts)
+
+	if m.ListEachFunc == nil {
+		return nil, ErrNotImplemented
+	}
+
+	return m.ListEachFunc(ctx, opts, fn, callOpts...)
+}
+
+// Search implements the mailerlite.SubscribersAPI interface.
+func (m *SubscribersService) Search(ctx context.Context, query string, opts *mailerlite.SubscriberSearchOptions, callOpts ...mailerlite.CallOption) ([]mailerlite.Subscriber, *mailerlite.Response, error) {
+	m.record("SubscribersService.Search", query, opts)
+
+	if m.SearchFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.SearchFunc(ctx, query, opts, callOpts...)
+}
+
+// Create implements the mailerlite.SubscribersAPI interface.
+func (m *SubscribersService) Create(ctx context.Context, newSubscriber mailerlite.NewSubscriber, callOpts ...mailerlite.CallOption) (*mailerlite.Subscriber, *mailerlite.Response, error) {
+	m.record("SubscribersService.Create", newSubscriber)
+
+	if m.CreateFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.CreateFunc(ctx, newSubscriber, callOpts...)
+}
+
+// Get implements the mailerlite.SubscribersAPI interface.
+func (m *SubscribersService) Get(ctx context.Context, email string, callOpts ...mailerlite.CallOption) (*mailerlite.Subscriber, *mailerlite.Response, error) {
+	m.record("SubscribersService.Get", email)
+
+	if m.GetFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.GetFunc(ctx, email, callOpts...)
+}
+
+// Update implements the mailerlite.SubscribersAPI interface.
+func (m *SubscribersService) Update(ctx context.Context, email string, update mailerlite.SubscriberUpdate, callOpts ...mailerlite.CallOption) (*mailerlite.Subscriber, *mailerlite.Response, error) {
+	m.record("SubscribersService.Update", email, update)
+
+	if m.UpdateFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.UpdateFunc(ctx, email, update, callOpts...)
+}
+
+// Groups implements the mailerlite.SubscribersAPI interface.
+func (m *SubscribersService) Groups(ctx context.Context, email string, callOpts ...mailerlite.CallOption) ([]mailerlite.Group, *mailerlite.Response, error) {
+	m.record("SubscribersService.Groups", email)
+
+	if m.GroupsFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.GroupsFunc(ctx, email, callOpts...)
+}
+
+// Upsert implements the mailerlite.SubscribersAPI interface.
+func (m *SubscribersService) Upsert(ctx context.Context, email string, data mailerlite.SubscriberUpsert, callOpts ...mailerlite.CallOption) (*mailerlite.UpsertResult, *mailerlite.Response, error) {
+	m.record("SubscribersService.Upsert", email, data)
+
+	if m.UpsertFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.UpsertFunc(ctx, email, data, callOpts...)
+}
+
+// GroupsService is a programmable fake of mailerlite.GroupsAPI.
+// Set the function of a method to script its response.
+type GroupsService struct {
+	Recorder
+
+	ListFunc             func(ctx context.Context, opts *mailerlite.GroupListOptions, callOpts ...mailerlite.CallOption) ([]mailerlite.Group, *mailerlite.Response, error)
+	SubscribersFunc      func(ctx context.Context, id int, opts *mailerlite.GroupSubscriberListOptions, callOpts ...mailerlite.CallOption) ([]mailerlite.Subscriber, *mailerlite.Response, error)
+	SubscribersEachFunc  func(ctx context.Context, id int, opts *mailerlite.GroupSubscriberListOptions, fn func(subscriber mailerlite.Subscriber) error, callOpts ...mailerlite.CallOption) (*mailerlite.Response, error)
+	AddSubscriberFunc    func(ctx context.Context, id int, newSubscriber mailerlite.NewSubscriberInGroup, callOpts ...mailerlite.CallOption) (*mailerlite.Subscriber, *mailerlite.Response, error)
+	RemoveSubscriberFunc func(ctx context.Context, id int, email string, callOpts ...mailerlite.CallOption) (*mailerlite.Response, error)
+}
+
+// List implements the mailerlite.GroupsAPI interface.
+func (m *GroupsService) List(ctx context.Context, opts *mailerlite.GroupListOptions, callOpts ...mailerlite.CallOption) ([]mailerlite.Group, *mailerlite.Response, error) {
+	m.record("GroupsService.List", opts)
+
+	if m.ListFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.ListFunc(ctx, opts, callOpts...)
+}
+
+// Subscribers implements the mailerlite.GroupsAPI interface.
+func (m *GroupsService) Subscribers(ctx context.Context, id int, opts *mailerlite.GroupSubscriberListOptions, callOpts ...mailerlite.CallOption) ([]mailerlite.Subscriber, *mailerlite.Response, error) {
+	m.record("GroupsService.Subscribers", id, opts)
+
+	if m.SubscribersFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.SubscribersFunc(ctx, id, opts, callOpts...)
+}
+
+// SubscribersEach implements the mailerlite.GroupsAPI interface.
+func (m *GroupsService) SubscribersEach(ctx context.Context, id int, opts *mailerlite.GroupSubscriberListOptions, fn func(subscriber mailerlite.Subscriber) error, callOpts ...mailerlite.CallOption) (*mailerlite.Response, error) {
+	m.record("GroupsService.SubscribersEach", id, opts)
+
+	if m.SubscribersEachFunc == nil {
+		return nil, ErrNotImplemented
+	}
+
+	return m.SubscribersEachFunc(ctx, id, opts, fn, callOpts...)
+}
+
+// AddSubscriber implements the mailerlite.GroupsAPI interface.
+func (m *GroupsService) AddSubscriber(ctx context.Context, id int, newSubscriber mailerlite.NewSubscriberInGroup, callOpts ...mailerlite.CallOption) (*mailerlite.Subscriber, *mailerlite.Response, error) {
+	m.record("GroupsService.AddSubscriber", id, newSubscriber)
+
+	if m.AddSubscriberFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.AddSubscriberFunc(ctx, id, newSubscriber, callOpts...)
+}
+
+// RemoveSubscriber implements the mailerlite.GroupsAPI interface.
+func (m *GroupsService) RemoveSubscriber(ctx context.Context, id int, email string, callOpts ...mailerlite.CallOption) (*mailerlite.Response, error) {
+	m.record("GroupsService.RemoveSubscriber", id, email)
+
+	if m.RemoveSubscriberFunc == nil {
+		return nil, ErrNotImplemented
+	}
+
+	return m.RemoveSubscriberFunc(ctx, id, email, callOpts...)
+}
+
+// FieldsService is a programmable fake of mailerlite.FieldsAPI.
+// Set the function of a method to script its response.
+type FieldsService struct {
+	Recorder
+
+	ListFunc   func(ctx context.Context, callOpts ...mailerlite.CallOption) ([]mailerlite.Field, *mailerlite.Response, error)
+	CreateFunc func(ctx context.Context, newField mailerlite.NewField, callOpts ...mailerlite.CallOption) (*mailerlite.Field, *mailerlite.Response, error)
+	UpdateFunc func(ctx context.Context, id int, update mailerlite.FieldUpdate, callOpts ...mailerlite.CallOption) (*mailerlite.Field, *mailerlite.Response, error)
+	DeleteFunc func(ctx context.Context, id int, callOpts ...mailerlite.CallOption) (*mailerlite.Response, error)
+	PlanFunc   func(ctx context.Context, definitions []mailerlite.FieldDefinition, opts *mailerlite.FieldPlanOptions, callOpts ...mailerlite.CallOption) (*mailerlite.FieldPlan, *mailerlite.Response, error)
+	ApplyFunc  func(ctx context.Context, plan *mailerlite.FieldPlan, callOpts ...mailerlite.CallOption) (*mailerlite.Response, error)
+}
+
+// List implements the mailerlite.FieldsAPI interface.
+func (m *FieldsService) List(ctx context.Context, callOpts ...mailerlite.CallOption) ([]mailerlite.Field, *mailerlite.Response, error) {
+	m.record("FieldsService.List")
+
+	if m.ListFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.ListFunc(ctx, callOpts...)
+}
+
+// Create implements the mailerlite.FieldsAPI interface.
+func (m *FieldsService) Create(ctx context.Context, newField mailerlite.NewField, callOpts ...mailerlite.CallOption) (*mailerlite.Field, *mailerlite.Response, error) {
+	m.record("FieldsService.Create", newField)
+
+	if m.CreateFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.CreateFunc(ctx, newField, callOpts...)
+}
+
+// Update implements the mailerlite.FieldsAPI interface.
+func (m *FieldsService) Update(ctx context.Context, id int, update mailerlite.FieldUpdate, callOpts ...mailerlite.CallOption) (*mailerlite.Field, *mailerlite.Response, error) {
+	m.record("FieldsService.Update", id, update)
+
+	if m.UpdateFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.UpdateFunc(ctx, id, update, callOpts...)
+}
+
+// Delete implements the mailerlite.FieldsAPI interface.
+func (m *FieldsService) Delete(ctx context.Context, id int, callOpts ...mailerlite.CallOption) (*mailerlite.Response, error) {
+	m.record("FieldsService.Delete", id)
+
+	if m.DeleteFunc == nil {
+		return nil, ErrNotImplemented
+	}
+
+	return m.DeleteFunc(ctx, id, callOpts...)
+}
+
+// Plan implements the mailerlite.FieldsAPI interface.
+func (m *FieldsService) Plan(ctx context.Context, definitions []mailerlite.FieldDefinition, opts *mailerlite.FieldPlanOptions, callOpts ...mailerlite.CallOption) (*mailerlite.FieldPlan, *mailerlite.Response, error) {
+	m.record("FieldsService.Plan", definitions, opts)
+
+	if m.PlanFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.PlanFunc(ctx, definitions, opts, callOpts...)
+}
+
+// Apply implements the mailerlite.FieldsAPI interface.
+func (m *FieldsService) Apply(ctx context.Context, plan *mailerlite.FieldPlan, callOpts ...mailerlite.CallOption) (*mailerlite.Response, error) {
+	m.record("FieldsService.Apply", plan)
+
+	if m.ApplyFunc == nil {
+		return nil, ErrNotImplemented
+	}
+
+	return m.ApplyFunc(ctx, plan, callOpts...)
+}
+
+// WebhooksService is a programmable fake of mailerlite.WebhooksAPI.
+// Set the function of a method to script its response.
+type WebhooksService struct {
+	Recorder
+
+	ListFunc func(ctx context.Context, callOpts ...mailerlite.CallOption) ([]mailerlite.Webhook, *mailerlite.Response, error)
+}
+
+// List implements the mailerlite.WebhooksAPI interface.
+func (m *WebhooksService) List(ctx context.Context, callOpts ...mailerlite.CallOption) ([]mailerlite.Webhook, *mailerlite.Response, error) {
+	m.record("WebhooksService.List")
+
+	if m.ListFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.ListFunc(ctx, callOpts...)
+}
+
+// StatsService is a programmable fake of mailerlite.StatsAPI.
+// Set the function of a method to script its response.
+type StatsService struct {
+	Recorder
+
+	GetFunc     func(ctx context.Context, opts *mailerlite.StatGetOptions, callOpts ...mailerlite.CallOption) (*mailerlite.Stats, *mailerlite.Response, error)
+	HistoryFunc func(ctx context.Context, from time.Time, to time.Time, step time.Duration, callOpts ...mailerlite.CallOption) (*mailerlite.StatsSeries, error)
+}
+
+// Get implements the mailerlite.StatsAPI interface.
+func (m *StatsService) Get(ctx context.Context, opts *mailerlite.StatGetOptions, callOpts ...mailerlite.CallOption) (*mailerlite.Stats, *mailerlite.Response, error) {
+	m.record("StatsService.Get", opts)
+
+	if m.GetFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.GetFunc(ctx, opts, callOpts...)
+}
+
+// History implements the mailerlite.StatsAPI interface.
+func (m *StatsService) History(ctx context.Context, from time.Time, to time.Time, step time.Duration, callOpts ...mailerlite.CallOption) (*mailerlite.StatsSeries, error) {
+	m.record("StatsService.History", from, to, step)
+
+	if m.HistoryFunc == nil {
+		return nil, ErrNotImplemented
+	}
+
+	return m.HistoryFunc(ctx, from, to, step, callOpts...)
+}
+
+// SuppressionsService is a programmable fake of mailerlite.SuppressionsAPI.
+// Set the function of a method to script its response.
+type SuppressionsService struct {
+	Recorder
+
+	ListFunc         func(ctx context.Context, opts *mailerlite.SuppressionListOptions, callOpts ...mailerlite.CallOption) ([]mailerlite.Suppression, *mailerlite.Response, error)
+	ListEachFunc     func(ctx context.Context, opts *mailerlite.SuppressionListOptions, fn func(suppression mailerlite.Suppression) error, callOpts ...mailerlite.CallOption) (*mailerlite.Response, error)
+	AddFunc          func(ctx context.Context, emails []string, callOpts ...mailerlite.CallOption) ([]mailerlite.Suppression, *mailerlite.Response, error)
+	RemoveFunc       func(ctx context.Context, emails []string, callOpts ...mailerlite.CallOption) ([]string, *mailerlite.Response, error)
+	ListDomainFunc   func(ctx context.Context, domain string, callOpts ...mailerlite.CallOption) ([]mailerlite.Suppression, error)
+	AddDomainFunc    func(ctx context.Context, domain string, callOpts ...mailerlite.CallOption) ([]mailerlite.Suppression, *mailerlite.Response, error)
+	RemoveDomainFunc func(ctx context.Context, domain string, callOpts ...mailerlite.CallOption) ([]string, *mailerlite.Response, error)
+	LoadFunc         func(ctx context.Context, callOpts ...mailerlite.CallOption) (*mailerlite.SuppressionList, error)
+	CheckFunc        func(ctx context.Context, emails []string, callOpts ...mailerlite.CallOption) ([]string, error)
+}
+
+// List implements the mailerlite.SuppressionsAPI interface.
+func (m *SuppressionsService) List(ctx context.Context, opts *mailerlite.SuppressionListOptions, callOpts ...mailerlite.CallOption) ([]mailerlite.Suppression, *mailerlite.Response, error) {
+	m.record("SuppressionsService.List", opts)
+
+	if m.ListFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.ListFunc(ctx, opts, callOpts...)
+}
+
+// ListEach implements the mailerlite.SuppressionsAPI interface.
+func (m *SuppressionsService) ListEach(ctx context.Context, opts *mailerlite.SuppressionListOptions, fn func(suppression mailerlite.Suppression) error, callOpts ...mailerlite.CallOption) (*mailerlite.Response, error) {
+	m.record("SuppressionsService.ListEach", opts)
+
+	if m.ListEachFunc == nil {
+		return nil, ErrNotImplemented
+	}
+
+	return m.ListEachFunc(ctx, opts, fn, callOpts...)
+}
+
+// Add implements the mailerlite.SuppressionsAPI interface.
+func (m *SuppressionsService) Add(ctx context.Context, emails []string, callOpts ...mailerlite.CallOption) ([]mailerlite.Suppression, *mailerlite.Response, error) {
+	m.record("SuppressionsService.Add", emails)
+
+	if m.AddFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.AddFunc(ctx, emails, callOpts...)
+}
+
+// Remove implements the mailerlite.SuppressionsAPI interface.
+func (m *SuppressionsService) Remove(ctx context.Context, emails []string, callOpts ...mailerlite.CallOption) ([]string, *mailerlite.Response, error) {
+	m.record("SuppressionsService.Remove", emails)
+
+	if m.RemoveFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.RemoveFunc(ctx, emails, callOpts...)
+}
+
+// ListDomain implements the mailerlite.SuppressionsAPI interface.
+func (m *SuppressionsService) ListDomain(ctx context.Context, domain string, callOpts ...mailerlite.CallOption) ([]mailerlite.Suppression, error) {
+	m.record("SuppressionsService.ListDomain", domain)
+
+	if m.ListDomainFunc == nil {
+		return nil, ErrNotImplemented
+	}
+
+	return m.ListDomainFunc(ctx, domain, callOpts...)
+}
+
+// AddDomain implements the mailerlite.SuppressionsAPI interface.
+func (m *SuppressionsService) AddDomain(ctx context.Context, domain string, callOpts ...mailerlite.CallOption) ([]mailerlite.Suppression, *mailerlite.Response, error) {
+	m.record("SuppressionsService.AddDomain", domain)
+
+	if m.AddDomainFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.AddDomainFunc(ctx, domain, callOpts...)
+}
+
+// RemoveDomain implements the mailerlite.SuppressionsAPI interface.
+func (m *SuppressionsService) RemoveDomain(ctx context.Context, domain string, callOpts ...mailerlite.CallOption) ([]string, *mailerlite.Response, error) {
+	m.record("SuppressionsService.RemoveDomain", domain)
+
+	if m.RemoveDomainFunc == nil {
+		return nil, nil, ErrNotImplemented
+	}
+
+	return m.RemoveDomainFunc(ctx, domain, callOpts...)
+}
+
+// Load implements the mailerlite.SuppressionsAPI interface.
+func (m *SuppressionsService) Load(ctx context.Context, callOpts ...mailerlite.CallOption) (*mailerlite.SuppressionList, error) {
+	m.record("SuppressionsService.Load")
+
+	if m.LoadFunc == nil {
+		return nil, ErrNotImplemented
+	}
+
+	return m.LoadFunc(ctx, callOpts...)
+}
+
+// Check implements the mailerlite.SuppressionsAPI interface.
+func (m *SuppressionsService) Check(ctx context.Context, emails []string, callOpts ...mailerlite.CallOption) ([]string, error) {
+	m.record("SuppressionsService.Check", emails)
+
+	if m.CheckFunc == nil {
+		return nil, ErrNotImplemented
+	}
+
+	return m.CheckFunc(ctx, emails, callOpts...)
+}
@@ -0,0 +1,40 @@
+package sync
+
+import (
+	"context"
+	gosync "sync"
+)
+
+// Checkpointer persists the progress of a sync, so an interrupted sync can be resumed.
+type Checkpointer interface {
+	// Load returns the email of the last synced user (or an empty string).
+	Load(ctx context.Context) (string, error)
+
+	// Save records the email of the last synced user.
+	// An empty email clears the checkpoint.
+	Save(ctx context.Context, email string) error
+}
+
+// MemoryCheckpointer is a Checkpointer that keeps the checkpoint in memory.
+type MemoryCheckpointer struct {
+	mu    gosync.Mutex
+	email string
+}
+
+// Load implements the Checkpointer interface.
+func (c *MemoryCheckpointer) Load(_ context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.email, nil
+}
+
+// Save implements the Checkpointer interface.
+func (c *MemoryCheckpointer) Save(_ context.Context, email string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.email = email
+
+	return nil
+}
@@ -0,0 +1,4 @@
+/*
+Package sync reconciles the users of an application with the subscribers of a MailerLite account.
+*/
+package sync
@@ -0,0 +1,126 @@
+package sync
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ChangeType represents the kind of change made to a subscriber.
+type ChangeType string
+
+const (
+	// Create a missing subscriber.
+	Create ChangeType = "create"
+
+	// Rename a subscriber.
+	Rename ChangeType = "rename"
+
+	// Update the value of a custom field.
+	UpdateField ChangeType = "update_field"
+
+	// Add a subscriber to a group.
+	AddToGroup ChangeType = "add_to_group"
+
+	// Remove a subscriber from a group.
+	RemoveFromGroup ChangeType = "remove_from_group"
+
+	// Unsubscribe a subscriber.
+	Unsubscribe ChangeType = "unsubscribe"
+)
+
+// Change is a single change made (or planned) to a subscriber.
+type Change struct {
+	Email string
+	Type  ChangeType
+
+	// Field is the key of the custom field (for UpdateField).
+	Field string
+
+	// Group is the ID of the group (for AddToGroup and RemoveFromGroup).
+	Group int
+
+	// Old and New are the previous and the new value (for Rename and UpdateField).
+	Old string
+	New string
+
+	// Redaction policy of the Syncer applied by String.
+	redact func(s string) string
+}
+
+// String describes the change.
+// Personal data is masked according to the redaction policy of the Syncer (see Redact).
+func (c Change) String() string {
+	if c.redact != nil {
+		return c.redact(c.describe())
+	}
+
+	return c.describe()
+}
+
+func (c Change) describe() string {
+	switch c.Type {
+	case Rename:
+		return fmt.Sprintf("%s: rename %q -> %q", c.Email, c.Old, c.New)
+
+	case UpdateField:
+		return fmt.Sprintf("%s: update field %s %q -> %q", c.Email, c.Field, c.Old, c.New)
+
+	case AddToGroup:
+		return fmt.Sprintf("%s: add to group %d", c.Email, c.Group)
+
+	case RemoveFromGroup:
+		return fmt.Sprintf("%s: remove from group %d", c.Email, c.Group)
+	}
+
+	return fmt.Sprintf("%s: %s", c.Email, c.Type)
+}
+
+// Skip is a user that was (partially) left untouched.
+type Skip struct {
+	Email  string
+	Reason string
+}
+
+// Report describes the outcome of a sync.
+type Report struct {
+	// DryRun is true if the changes were only planned, but not applied.
+	DryRun bool
+
+	// Changes made (or planned) to subscribers.
+	Changes []Change
+
+	// Skipped users (eg. because resubscribing them is not allowed).
+	Skipped []Skip
+
+	// Number of users processed.
+	Processed int
+
+	// Number of users that did not require any change.
+	Unchanged int
+
+	// Redaction policy of the Syncer applied by String.
+	redact func(s string) string
+}
+
+// String summarizes the report, one line per change.
+// Personal data is masked according to the redaction policy of the Syncer (see Redact).
+func (r *Report) String() string {
+	var b strings.Builder
+
+	for _, change := range r.Changes {
+		b.WriteString(change.describe())
+		b.WriteString("\n")
+	}
+
+	for _, skip := range r.Skipped {
+		fmt.Fprintf(&b, "%s: skipped: %s\n", skip.Email, skip.Reason)
+	}
+
+	fmt.Fprintf(&b, "%d processed, %d changes, %d unchanged, %d skipped\n", r.Processed, len(r.Changes), r.Unchanged, len(r.Skipped))
+
+	if r.redact != nil {
+		return r.redact(b.String())
+	}
+
+	return b.String()
+}
@@ -0,0 +1,286 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/sagikazarmark/go-mailerlite/mailerlite"
+)
+
+// Source is the source of truth of a sync: it enumerates the users of an application.
+type Source interface {
+	// Users calls fn for every user.
+	// Users must be enumerated in ascending order of their email addresses for checkpointing to work.
+	// Enumeration must stop when fn returns an error, and the error must be returned.
+	Users(ctx context.Context, fn func(user User) error) error
+}
+
+// User is the state of a user in the application.
+type User struct {
+	Email string
+
+	// Name of the user. Left untouched in MailerLite if empty.
+	Name string
+
+	// Custom field values keyed by field key.
+	// Fields not present in the map are left untouched in MailerLite.
+	Fields map[string]mailerlite.FieldValue
+
+	// IDs of the groups the user should belong to.
+	Groups []int
+
+	// Subscribed reports whether the user wants to receive emails.
+	Subscribed bool
+}
+
+// Option configures a Syncer.
+type Option interface {
+	apply(s *Syncer)
+}
+
+type optionFunc func(s *Syncer)
+
+func (fn optionFunc) apply(s *Syncer) {
+	fn(s)
+}
+
+// DryRun configures a Syncer to only plan changes without applying them.
+func DryRun() Option {
+	return optionFunc(func(s *Syncer) {
+		s.dryRun = true
+	})
+}
+
+// WithCheckpointer configures a Syncer to record its progress, so an interrupted sync can be resumed.
+// Checkpoints are not recorded during dry runs.
+func WithCheckpointer(checkpointer Checkpointer) Option {
+	return optionFunc(func(s *Syncer) {
+		s.checkpointer = checkpointer
+	})
+}
+
+// ManageGroups configures a Syncer to remove subscribers from the listed groups
+// when the corresponding user does not belong to them.
+// By default group memberships are only ever added.
+func ManageGroups(ids ...int) Option {
+	return optionFunc(func(s *Syncer) {
+		for _, id := range ids {
+			s.managedGroups[id] = true
+		}
+	})
+}
+
+// Redact configures a Syncer to mask personal data in reports and errors according to policy.
+// Defaults to the redaction policy of the client if it is a *mailerlite.Client (see mailerlite.RedactPII).
+func Redact(policy mailerlite.RedactionPolicy) Option {
+	return optionFunc(func(s *Syncer) {
+		s.redact = policy
+	})
+}
+
+// Syncer reconciles the users of a Source with the subscribers of a MailerLite account.
+//
+// Subscribers who unsubscribed or were marked as junk in MailerLite are never resubscribed:
+// users who are subscribed in the application are skipped entirely in that case.
+type Syncer struct {
+	client mailerlite.API
+
+	dryRun        bool
+	checkpointer  Checkpointer
+	managedGroups map[int]bool
+	redact        mailerlite.RedactionPolicy
+}
+
+// New returns a new Syncer.
+func New(client mailerlite.API, opts ...Option) *Syncer {
+	s := &Syncer{
+		client:        client,
+		managedGroups: make(map[int]bool),
+	}
+
+	if c, ok := client.(*mailerlite.Client); ok {
+		s.redact = c.Redact
+	}
+
+	for _, opt := range opts {
+		opt.apply(s)
+	}
+
+	return s
+}
+
+// Plan returns the changes required to reconcile the users of source with MailerLite, without applying them.
+func (s *Syncer) Plan(ctx context.Context, source Source) (*Report, error) {
+	return s.run(ctx, source, true)
+}
+
+// Run reconciles the users of source with MailerLite.
+//
+// Run stops at the first API error, returning the report of the changes made so far.
+// If a Checkpointer is configured, the next Run resumes after the last synced user.
+func (s *Syncer) Run(ctx context.Context, source Source) (*Report, error) {
+	return s.run(ctx, source, s.dryRun)
+}
+
+func (s *Syncer) run(ctx context.Context, source Source, dryRun bool) (*Report, error) {
+	report := &Report{DryRun: dryRun, redact: s.redact}
+
+	fields, _, err := s.client.FieldsAPI().List(ctx)
+	if err != nil {
+		return report, fmt.Errorf("listing fields: %w", err)
+	}
+
+	checkpointer := s.checkpointer
+	if dryRun {
+		checkpointer = nil
+	}
+
+	var checkpoint string
+	if checkpointer != nil {
+		checkpoint, err = checkpointer.Load(ctx)
+		if err != nil {
+			return report, fmt.Errorf("loading checkpoint: %w", err)
+		}
+	}
+
+	err = source.Users(ctx, func(user User) error {
+		if checkpoint != "" && user.Email <= checkpoint {
+			return nil
+		}
+
+		err := s.syncUser(ctx, user, fields, report, dryRun)
+		if err != nil {
+			return fmt.Errorf("syncing %s: %w", s.redactString(user.Email), err)
+		}
+
+		report.Processed++
+
+		if checkpointer != nil {
+			err := checkpointer.Save(ctx, user.Email)
+			if err != nil {
+				return fmt.Errorf("saving checkpoint: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return report, err
+	}
+
+	if checkpointer != nil {
+		err := checkpointer.Save(ctx, "")
+		if err != nil {
+			return report, fmt.Errorf("clearing checkpoint: %w", err)
+		}
+	}
+
+	return report, nil
+}
+
+func (s *Syncer) syncUser(ctx context.Context, user User, fields []mailerlite.Field, report *Report, dryRun bool) error {
+	if err := mailerlite.ValidateFieldValues(fields, user.Fields); err != nil {
+		report.Skipped = append(report.Skipped, Skip{Email: user.Email, Reason: err.Error()})
+
+		return nil
+	}
+
+	var removeFromGroups []int
+
+	desired := make(map[int]bool, len(user.Groups))
+	for _, id := range user.Groups {
+		desired[id] = true
+	}
+
+	for id := range s.managedGroups {
+		if !desired[id] {
+			removeFromGroups = append(removeFromGroups, id)
+		}
+	}
+
+	sort.Ints(removeFromGroups)
+
+	result, _, err := s.client.SubscribersAPI().Upsert(ctx, user.Email, mailerlite.SubscriberUpsert{
+		Name:             user.Name,
+		Fields:           user.Fields,
+		Groups:           user.Groups,
+		RemoveFromGroups: removeFromGroups,
+		Unsubscribe:      !user.Subscribed,
+		SkipUnsubscribed: user.Subscribed,
+		DryRun:           dryRun,
+	})
+	if result == nil {
+		return err
+	}
+
+	if result.Skipped {
+		report.Skipped = append(report.Skipped, Skip{
+			Email:  user.Email,
+			Reason: fmt.Sprintf("subscriber is %s in MailerLite, not resubscribing", result.Previous.Type),
+		})
+
+		return err
+	}
+
+	changes := upsertChanges(user.Email, result)
+
+	if len(changes) == 0 && err == nil {
+		report.Unchanged++
+	}
+
+	for i := range changes {
+		changes[i].redact = report.redact
+	}
+
+	report.Changes = append(report.Changes, changes...)
+
+	return err
+}
+
+// upsertChanges lists the changes made (or planned) by an upsert.
+func upsertChanges(email string, result *mailerlite.UpsertResult) []Change {
+	var changes []Change
+
+	if result.Created {
+		changes = append(changes, Change{Email: email, Type: Create})
+	}
+
+	if update := result.Update; update != nil {
+		if update.Name != "" {
+			changes = append(changes, Change{Email: email, Type: Rename, Old: result.Previous.Name, New: update.Name})
+		}
+
+		previous := make(map[string]mailerlite.FieldValue, len(result.Previous.Fields))
+		for _, field := range result.Previous.Fields {
+			previous[field.Key] = field.Value
+		}
+
+		for _, field := range update.Fields {
+			changes = append(changes, Change{Email: email, Type: UpdateField, Field: field.Key, Old: previous[field.Key].Text(), New: field.Value.Text()})
+		}
+
+		if update.Type == mailerlite.Unsubscribed {
+			changes = append(changes, Change{Email: email, Type: Unsubscribe})
+		}
+	}
+
+	for _, id := range result.AddedGroups {
+		changes = append(changes, Change{Email: email, Type: AddToGroup, Group: id})
+	}
+
+	for _, id := range result.RemovedGroups {
+		changes = append(changes, Change{Email: email, Type: RemoveFromGroup, Group: id})
+	}
+
+	return changes
+}
+
+// redactString applies the redaction policy of the Syncer to str.
+func (s *Syncer) redactString(str string) string {
+	if s.redact == nil {
+		return str
+	}
+
+	return s.redact(str)
+}
@@ -0,0 +1,213 @@
+package sync_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/sagikazarmark/go-mailerlite/mailerlite"
+	"github.com/sagikazarmark/go-mailerlite/mailerlitemock"
+	"github.com/sagikazarmark/go-mailerlite/sync"
+)
+
+type users []sync.User
+
+func (u users) Users(_ context.Context, fn func(user sync.User) error) error {
+	for _, user := range u {
+		if err := fn(user); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func newClient(t *testing.T, handler http.Handler, opts ...mailerlite.ClientOption) *mailerlite.Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	baseURL, _ := url.Parse(server.URL + "/")
+
+	client, err := mailerlite.NewClient("api-key", append([]mailerlite.ClientOption{mailerlite.BaseURL(baseURL)}, opts...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client
+}
+
+func TestSyncer_EmptyTextIsUnchanged(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/fields", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"id":1,"title":"Company","key":"company","type":"TEXT"}]`))
+	})
+	mux.HandleFunc("/subscribers/john@example.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected %s request", r.Method)
+		}
+
+		_, _ = w.Write([]byte(`{"id":1,"email":"john@example.com","type":"active","fields":[{"key":"company","value":"","type":"TEXT"}]}`))
+	})
+	mux.HandleFunc("/subscribers/john@example.com/groups", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[]`))
+	})
+
+	syncer := sync.New(newClient(t, mux))
+
+	report, err := syncer.Run(context.Background(), users{
+		{
+			Email:      "john@example.com",
+			Fields:     map[string]mailerlite.FieldValue{"company": mailerlite.TextValue("")},
+			Subscribed: true,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(report.Changes) != 0 || report.Unchanged != 1 {
+		t.Errorf("expected the user to be unchanged:\n%s", report)
+	}
+}
+
+func TestSyncer_SkipIsNotAChange(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/fields", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"id":1,"title":"Company","key":"company","type":"TEXT"}]`))
+	})
+	mux.HandleFunc("/subscribers/john@example.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected %s request", r.Method)
+		}
+
+		_, _ = w.Write([]byte(`{"id":1,"email":"john@example.com","name":"John","type":"unsubscribed","fields":[]}`))
+	})
+
+	syncer := sync.New(newClient(t, mux))
+
+	report, err := syncer.Run(context.Background(), users{
+		{
+			Email:      "john@example.com",
+			Name:       "John Doe",
+			Fields:     map[string]mailerlite.FieldValue{"company": mailerlite.TextValue("Acme")},
+			Subscribed: true,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(report.Skipped) != 1 {
+		t.Errorf("expected the user to be skipped:\n%s", report)
+	}
+
+	if len(report.Changes) != 0 || report.Unchanged != 0 {
+		t.Errorf("skipped users should not be counted as changed or unchanged:\n%s", report)
+	}
+}
+
+func TestSyncer_Plan_UnsubscribeAndManagedGroups(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/fields", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[]`))
+	})
+	mux.HandleFunc("/subscribers/john@example.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected %s request", r.Method)
+		}
+
+		_, _ = w.Write([]byte(`{"id":1,"email":"john@example.com","type":"active","fields":[]}`))
+	})
+	mux.HandleFunc("/subscribers/john@example.com/groups", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"id":1,"name":"Customers"},{"id":2,"name":"Newsletter"}]`))
+	})
+
+	syncer := sync.New(newClient(t, mux), sync.ManageGroups(1))
+
+	report, err := syncer.Plan(context.Background(), users{
+		{Email: "john@example.com"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(report.Changes) != 2 || report.Changes[0].Type != sync.Unsubscribe || report.Changes[1].Type != sync.RemoveFromGroup || report.Changes[1].Group != 1 {
+		t.Errorf("unexpected changes:\n%s", report)
+	}
+}
+
+func TestChange_String_Redacted(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/fields", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[]`))
+	})
+	mux.HandleFunc("/subscribers/john@example.com", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":1,"email":"john@example.com","type":"active","fields":[]}`))
+	})
+	mux.HandleFunc("/subscribers/john@example.com/groups", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[]`))
+	})
+
+	syncer := sync.New(newClient(t, mux, mailerlite.RedactPII(nil)))
+
+	report, err := syncer.Plan(context.Background(), users{
+		{Email: "john@example.com"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(report.Changes) != 1 {
+		t.Fatalf("unexpected changes:\n%s", report)
+	}
+
+	if got := report.Changes[0].String(); strings.Contains(got, "john@example.com") {
+		t.Errorf("change leaks the email address: %s", got)
+	}
+}
+
+func TestSyncer_Run_Mock(t *testing.T) {
+	api := mailerlitemock.NewAPI()
+	api.Fields.ListFunc = func(ctx context.Context, callOpts ...mailerlite.CallOption) ([]mailerlite.Field, *mailerlite.Response, error) {
+		return []mailerlite.Field{{ID: 1, Title: "Company", Key: "company", Type: mailerlite.Text}}, nil, nil
+	}
+	api.Subscribers.UpsertFunc = func(ctx context.Context, email string, data mailerlite.SubscriberUpsert, callOpts ...mailerlite.CallOption) (*mailerlite.UpsertResult, *mailerlite.Response, error) {
+		return &mailerlite.UpsertResult{Created: true, AddedGroups: data.Groups}, nil, nil
+	}
+
+	syncer := sync.New(api, sync.Redact(mailerlite.RedactEmails))
+
+	report, err := syncer.Run(context.Background(), users{
+		{
+			Email:      "john@example.com",
+			Fields:     map[string]mailerlite.FieldValue{"company": mailerlite.TextValue("Acme")},
+			Groups:     []int{1},
+			Subscribed: true,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	calls := api.Subscribers.CallsTo("SubscribersService.Upsert")
+	if len(calls) != 1 || calls[0].Args[0] != "john@example.com" {
+		t.Fatalf("unexpected upserts: %+v", calls)
+	}
+
+	if data := calls[0].Args[1].(mailerlite.SubscriberUpsert); data.Unsubscribe || !data.SkipUnsubscribed || data.DryRun {
+		t.Errorf("unexpected upsert: %+v", data)
+	}
+
+	if len(report.Changes) != 2 || report.Changes[0].Type != sync.Create || report.Changes[1].Type != sync.AddToGroup {
+		t.Errorf("unexpected changes:\n%s", report)
+	}
+
+	if strings.Contains(report.String(), "john@example.com") {
+		t.Errorf("report leaks the email address:\n%s", report)
+	}
+}
@@ -0,0 +1,291 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	gosync "sync"
+	"time"
+
+	"github.com/sagikazarmark/go-mailerlite/mailerlite"
+)
+
+const (
+	// defaultPageSize is the number of subscribers requested per page.
+	defaultPageSize = 1000
+
+	// pageOverlap is the number of subscribers requested again at the start of the next page.
+	pageOverlap = 50
+
+	// maxListAttempts is the number of times paging through a changing list is restarted.
+	maxListAttempts = 3
+)
+
+// Event reports that a subscriber became non-mailable in MailerLite.
+type Event struct {
+	Email string
+
+	// Type is the current subscription state (Unsubscribed, Bounced or Junk).
+	Type mailerlite.SubscriptionType
+
+	// Time is when the subscription state changed.
+	Time time.Time
+}
+
+// Watermark is the position of a Watcher in the stream of changes.
+//
+// Change times have a resolution of one second, so the emails of the subscribers
+// already reported at the watermark time are kept to avoid reporting them again,
+// while still reporting other subscribers changing within the same second.
+type Watermark struct {
+	// Time of the last change reported.
+	Time time.Time
+
+	// Emails of the subscribers whose change at Time was reported.
+	Emails []string
+}
+
+// WatermarkStore persists the watermark of a Watcher.
+type WatermarkStore interface {
+	// Load returns the last saved watermark (or the zero value).
+	Load(ctx context.Context) (Watermark, error)
+
+	// Save records a new watermark.
+	Save(ctx context.Context, watermark Watermark) error
+}
+
+// MemoryWatermarkStore is a WatermarkStore that keeps the watermark in memory.
+type MemoryWatermarkStore struct {
+	mu        gosync.Mutex
+	watermark Watermark
+}
+
+// Load implements the WatermarkStore interface.
+func (s *MemoryWatermarkStore) Load(_ context.Context) (Watermark, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.watermark, nil
+}
+
+// Save implements the WatermarkStore interface.
+func (s *MemoryWatermarkStore) Save(_ context.Context, watermark Watermark) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.watermark = watermark
+
+	return nil
+}
+
+// Watcher reports subscribers who unsubscribed, bounced or were marked as junk in MailerLite,
+// so the application can mark the corresponding users as non-mailable.
+//
+// The API cannot filter subscribers by the time of their last change,
+// so every poll pages through the full lists of unsubscribed, bounced and junk subscribers.
+// For large accounts, consider receiving changes through webhooks instead (see WebhooksService).
+type Watcher struct {
+	client mailerlite.API
+	store  WatermarkStore
+
+	pageSize int
+}
+
+// NewWatcher returns a new Watcher.
+func NewWatcher(client mailerlite.API, store WatermarkStore) *Watcher {
+	return &Watcher{
+		client:   client,
+		store:    store,
+		pageSize: defaultPageSize,
+	}
+}
+
+// Poll calls fn for every subscriber whose subscription state changed to Unsubscribed,
+// Bounced or Junk since the stored watermark.
+//
+// Events are delivered at least once, so fn must be idempotent:
+// the watermark is only advanced once every change was handled successfully,
+// so an interrupted Poll (or one failing to save the watermark) emits the same events again.
+// Poll fails without advancing the watermark if a list keeps changing while it is paged through.
+func (w *Watcher) Poll(ctx context.Context, fn func(event Event) error) error {
+	watermark, err := w.store.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("loading watermark: %w", err)
+	}
+
+	reported := make(map[string]bool, len(watermark.Emails))
+	for _, email := range watermark.Emails {
+		reported[email] = true
+	}
+
+	next := Watermark{
+		Time:   watermark.Time,
+		Emails: append([]string(nil), watermark.Emails...),
+	}
+
+	for _, typ := range []mailerlite.SubscriptionType{mailerlite.Unsubscribed, mailerlite.Bounced, mailerlite.Junk} {
+		err := w.list(ctx, typ, func(subscriber mailerlite.Subscriber) error {
+			changed := changeTime(subscriber)
+			if changed.Before(watermark.Time) || (changed.Equal(watermark.Time) && reported[subscriber.Email]) {
+				return nil
+			}
+
+			err := fn(Event{
+				Email: subscriber.Email,
+				Type:  subscriber.Type,
+				Time:  changed,
+			})
+			if err != nil {
+				return err
+			}
+
+			switch {
+			case changed.After(next.Time):
+				next = Watermark{Time: changed, Emails: []string{subscriber.Email}}
+
+			case changed.Equal(next.Time):
+				next.Emails = append(next.Emails, subscriber.Email)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if next.Time.Equal(watermark.Time) && len(next.Emails) == len(watermark.Emails) {
+		return nil
+	}
+
+	err = w.store.Save(ctx, next)
+	if err != nil {
+		return fmt.Errorf("saving watermark: %w", err)
+	}
+
+	return nil
+}
+
+// errListChanged is returned when a subscriber list changes while it is paged through.
+var errListChanged = errors.New("subscriber list changed while paging")
+
+// list calls fn once for every subscriber of a type, restarting if the list changes while it is paged through.
+func (w *Watcher) list(ctx context.Context, typ mailerlite.SubscriptionType, fn func(subscriber mailerlite.Subscriber) error) error {
+	seen := make(map[string]bool)
+
+	var err error
+
+	for attempt := 0; attempt < maxListAttempts; attempt++ {
+		err = w.listPages(ctx, typ, func(subscriber mailerlite.Subscriber) error {
+			if seen[subscriber.Email] {
+				return nil
+			}
+
+			seen[subscriber.Email] = true
+
+			return fn(subscriber)
+		})
+		if !errors.Is(err, errListChanged) {
+			return err
+		}
+	}
+
+	return fmt.Errorf("listing %s subscribers: %w", typ, err)
+}
+
+// listPages pages through the subscribers of a type.
+//
+// Offset based paging skips entries when entries before the current offset are removed from the list
+// (eg. when a subscriber is resubscribed). Consecutive pages overlap, so such shifts are detected:
+// the last subscriber of a page must appear again in the next one.
+func (w *Watcher) listPages(ctx context.Context, typ mailerlite.SubscriptionType, fn func(subscriber mailerlite.Subscriber) error) error {
+	opts := &mailerlite.SubscriberListOptions{
+		Type: typ,
+		ListOptions: mailerlite.ListOptions{
+			Limit: w.pageSize,
+		},
+	}
+
+	overlap := pageOverlap
+	if overlap >= w.pageSize {
+		overlap = w.pageSize - 1
+	}
+
+	var last string
+
+	for {
+		subscribers, _, err := w.client.SubscribersAPI().List(ctx, opts)
+		if err != nil {
+			return fmt.Errorf("listing %s subscribers: %w", typ, err)
+		}
+
+		if last != "" && !containsEmail(subscribers, last) {
+			return errListChanged
+		}
+
+		for _, subscriber := range subscribers {
+			if err := fn(subscriber); err != nil {
+				return err
+			}
+		}
+
+		if len(subscribers) < opts.Limit {
+			return nil
+		}
+
+		last = subscribers[len(subscribers)-1].Email
+		opts.Offset += len(subscribers) - overlap
+	}
+}
+
+func containsEmail(subscribers []mailerlite.Subscriber, email string) bool {
+	for _, subscriber := range subscribers {
+		if subscriber.Email == email {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Watch polls for changes every interval and sends them on events until ctx is canceled.
+// Watch does not close events.
+func (w *Watcher) Watch(ctx context.Context, interval time.Duration, events chan<- Event) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		err := w.Poll(ctx, func(event Event) error {
+			select {
+			case events <- event:
+				return nil
+
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		if err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case <-ticker.C:
+		}
+	}
+}
+
+// changeTime returns when the subscription state of a subscriber last changed.
+func changeTime(subscriber mailerlite.Subscriber) time.Time {
+	if subscriber.Type == mailerlite.Unsubscribed && subscriber.DateUnsubscribe != nil {
+		return subscriber.DateUnsubscribe.Time
+	}
+
+	if subscriber.DateUpdated != nil {
+		return subscriber.DateUpdated.Time
+	}
+
+	return subscriber.DateCreated.Time
+}
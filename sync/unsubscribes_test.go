@@ -0,0 +1,166 @@
+package sync
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	gosync "sync"
+	"testing"
+
+	"github.com/sagikazarmark/go-mailerlite/mailerlite"
+)
+
+// subscriberLists serves subscriber lists by type with offset based paging.
+type subscriberLists struct {
+	mu    gosync.Mutex
+	lists map[string][]map[string]interface{}
+
+	// onPage is called after serving a page (optional).
+	onPage func(typ string, offset int)
+}
+
+func (l *subscriberLists) add(typ string, email string, dateUnsubscribe string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.lists[typ] = append(l.lists[typ], map[string]interface{}{
+		"email":            email,
+		"type":             typ,
+		"date_unsubscribe": dateUnsubscribe,
+		"date_updated":     dateUnsubscribe,
+	})
+}
+
+func (l *subscriberLists) remove(typ string, index int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.lists[typ] = append(l.lists[typ][:index:index], l.lists[typ][index+1:]...)
+}
+
+func (l *subscriberLists) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	typ := r.URL.Query().Get("type")
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	l.mu.Lock()
+	list := l.lists[typ]
+
+	page := []map[string]interface{}{}
+	for i := offset; i < len(list) && i < offset+limit; i++ {
+		page = append(page, list[i])
+	}
+	l.mu.Unlock()
+
+	_ = json.NewEncoder(w).Encode(page)
+
+	if l.onPage != nil {
+		l.onPage(typ, offset)
+	}
+}
+
+func newWatcher(t *testing.T, lists *subscriberLists, store WatermarkStore) *Watcher {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.Handle("/subscribers", lists)
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	baseURL, _ := url.Parse(server.URL + "/")
+
+	client, err := mailerlite.NewClient("api-key", mailerlite.BaseURL(baseURL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return NewWatcher(client, store)
+}
+
+func poll(t *testing.T, watcher *Watcher) []string {
+	t.Helper()
+
+	var emails []string
+
+	err := watcher.Poll(context.Background(), func(event Event) error {
+		emails = append(emails, event.Email)
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return emails
+}
+
+func TestWatcher_SameSecond(t *testing.T) {
+	lists := &subscriberLists{lists: make(map[string][]map[string]interface{})}
+	watcher := newWatcher(t, lists, &MemoryWatermarkStore{})
+
+	lists.add("unsubscribed", "john@example.com", "2022-01-01 10:00:00")
+
+	if got := poll(t, watcher); len(got) != 1 || got[0] != "john@example.com" {
+		t.Fatalf("first poll: unexpected events: %v", got)
+	}
+
+	// Another subscriber unsubscribes within the same second as the watermark
+	lists.add("unsubscribed", "jane@example.com", "2022-01-01 10:00:00")
+
+	if got := poll(t, watcher); len(got) != 1 || got[0] != "jane@example.com" {
+		t.Fatalf("second poll: unexpected events: %v", got)
+	}
+
+	if got := poll(t, watcher); len(got) != 0 {
+		t.Fatalf("third poll: unexpected events: %v", got)
+	}
+
+	lists.add("bounced", "joe@example.com", "2022-01-01 10:00:01")
+
+	if got := poll(t, watcher); len(got) != 1 || got[0] != "joe@example.com" {
+		t.Fatalf("fourth poll: unexpected events: %v", got)
+	}
+}
+
+func TestWatcher_ListShift(t *testing.T) {
+	lists := &subscriberLists{lists: make(map[string][]map[string]interface{})}
+	watcher := newWatcher(t, lists, &MemoryWatermarkStore{})
+	watcher.pageSize = 4
+
+	emails := []string{"a@example.com", "b@example.com", "c@example.com", "d@example.com", "e@example.com", "f@example.com", "g@example.com"}
+	for _, email := range emails {
+		lists.add("unsubscribed", email, "2022-01-01 10:00:00")
+	}
+
+	// Remove entries from the first page after it has been served,
+	// shifting the rest of the list beyond the overlap of the pages.
+	var shifted bool
+	lists.onPage = func(typ string, offset int) {
+		if typ != "unsubscribed" || offset != 0 || shifted {
+			return
+		}
+
+		shifted = true
+
+		for i := 0; i < 4; i++ {
+			lists.remove("unsubscribed", 0)
+		}
+	}
+
+	got := poll(t, watcher)
+
+	seen := make(map[string]int)
+	for _, email := range got {
+		seen[email]++
+	}
+
+	for _, email := range emails {
+		if seen[email] != 1 {
+			t.Errorf("%s reported %d times", email, seen[email])
+		}
+	}
+}